@@ -0,0 +1,147 @@
+package addressutil
+
+import (
+	"github.com/suyhuai/addressutil/util/adautil"
+)
+
+// ADAAddress is a bech32 Cardano Shelley address.
+type ADAAddress struct {
+	addr    string
+	decoded *adautil.Address
+}
+
+// NewADAAddress returns the enterprise address of the ed25519 payment key
+// pubKey on the network net, the address NewAddress derives for "ADA".
+func NewADAAddress(pubKey interface{}, net Network) (*ADAAddress, error) {
+	payment, err := adaCredential(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return newADAAddress(&adautil.Address{
+		Kind:    adautil.Enterprise,
+		Payment: payment,
+	}, net)
+}
+
+// NewADABaseAddress returns the base address of the ed25519 payment key
+// paymentKey delegating to the ed25519 stake key stakeKey on the network net.
+func NewADABaseAddress(paymentKey, stakeKey interface{}, net Network) (*ADAAddress, error) {
+	payment, err := adaCredential(paymentKey)
+	if err != nil {
+		return nil, err
+	}
+	stake, err := adaCredential(stakeKey)
+	if err != nil {
+		return nil, err
+	}
+	return newADAAddress(&adautil.Address{
+		Kind:    adautil.Base,
+		Payment: payment,
+		Stake:   stake,
+	}, net)
+}
+
+// NewADAPointerAddress returns the pointer address of the ed25519 payment
+// key pubKey whose stake credential was registered at ptr on the network net.
+func NewADAPointerAddress(pubKey interface{}, ptr adautil.ChainPointer, net Network) (*ADAAddress, error) {
+	payment, err := adaCredential(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return newADAAddress(&adautil.Address{
+		Kind:    adautil.Pointer,
+		Payment: payment,
+		Pointer: ptr,
+	}, net)
+}
+
+// NewADARewardAddress returns the reward ("stake1…") address of the ed25519
+// stake key stakeKey on the network net.
+func NewADARewardAddress(stakeKey interface{}, net Network) (*ADAAddress, error) {
+	stake, err := adaCredential(stakeKey)
+	if err != nil {
+		return nil, err
+	}
+	return newADAAddress(&adautil.Address{
+		Kind:  adautil.Reward,
+		Stake: stake,
+	}, net)
+}
+
+func newADAAddress(a *adautil.Address, net Network) (*ADAAddress, error) {
+	tag, err := adaNetworkTag(net)
+	if err != nil {
+		return nil, err
+	}
+	a.Network = tag
+
+	addr, err := a.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ADAAddress{
+		addr:    addr,
+		decoded: a,
+	}, nil
+}
+
+// ParseADAAddress parses a base, pointer, enterprise or reward address of
+// the network net.
+func ParseADAAddress(address string, net Network) (*ADAAddress, error) {
+	tag, err := adaNetworkTag(net)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := adautil.Decode(address)
+	if err != nil || decoded.Network != tag {
+		return nil, ErrAddressFormat
+	}
+
+	return &ADAAddress{
+		addr:    address,
+		decoded: decoded,
+	}, nil
+}
+
+// CheckADAAddress reports whether address can receive payments on the network
+// net.  Reward ("stake1…") addresses only hold staking rewards, so unlike
+// ParseADAAddress it rejects them.
+func CheckADAAddress(address string, net Network) bool {
+	a, err := ParseADAAddress(address, net)
+	return err == nil && a.decoded.Kind != adautil.Reward
+}
+
+func (a *ADAAddress) String() string {
+	return a.addr
+}
+
+func (a *ADAAddress) Url() string {
+	return a.String()
+}
+
+// Decoded returns the kind, network tag and credentials of the address.
+func (a *ADAAddress) Decoded() *adautil.Address {
+	return a.decoded
+}
+
+func adaCredential(pubKey interface{}) (adautil.Credential, error) {
+	pub, err := parseEd25519PubKey(pubKey)
+	if err != nil {
+		return adautil.Credential{}, err
+	}
+	return adautil.KeyCredential(pub), nil
+}
+
+// adaNetworkTag returns the address network tag of net.  Cardano's test
+// networks share one tag.
+func adaNetworkTag(net Network) (byte, error) {
+	switch net {
+	case MainNet:
+		return adautil.MainNet, nil
+	case TestNet:
+		return adautil.TestNet, nil
+	default:
+		return 0, ErrUnsupportedNetwork
+	}
+}
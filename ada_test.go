@@ -0,0 +1,98 @@
+package addressutil
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/suyhuai/addressutil/util/adautil"
+)
+
+// Test vectors from CIP-19.
+func TestADAAddress(t *testing.T) {
+	payment, _ := hex.DecodeString("73fea80d424276ad0978d4fe5310e8bc2d485f5f6bb3bf87612989f112ad5a7d")
+	stake, _ := hex.DecodeString("09ab278d49b7b86a055185c474c4942281ddfa05a54684c7e8a6f230625aee57")
+	ptr := adautil.ChainPointer{Slot: 2498243, TxIndex: 27, CertIndex: 3}
+
+	newBase := func(net Network) (*ADAAddress, error) { return NewADABaseAddress(payment, stake, net) }
+	newPointer := func(net Network) (*ADAAddress, error) { return NewADAPointerAddress(payment, ptr, net) }
+	newEnterprise := func(net Network) (*ADAAddress, error) { return NewADAAddress(payment, net) }
+	newReward := func(net Network) (*ADAAddress, error) { return NewADARewardAddress(stake, net) }
+
+	tests := []struct {
+		build func(Network) (*ADAAddress, error)
+		net   Network
+		kind  adautil.Kind
+		addr  string
+	}{
+		{newBase, MainNet, adautil.Base, "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x"},
+		{newBase, TestNet, adautil.Base, "addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae"},
+		{newPointer, MainNet, adautil.Pointer, "addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k"},
+		{newEnterprise, MainNet, adautil.Enterprise, "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"},
+		{newEnterprise, TestNet, adautil.Enterprise, "addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz"},
+		{newReward, MainNet, adautil.Reward, "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+		{newReward, TestNet, adautil.Reward, "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn"},
+	}
+
+	for _, test := range tests {
+		if a, err := test.build(test.net); err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if a.String() != test.addr {
+			t.Log("Address mismatch", a, test.addr)
+			t.Fail()
+		}
+
+		a, err := ParseADAAddress(test.addr, test.net)
+		if err != nil {
+			t.Log("Address parse failed", test.addr, err)
+			t.Fail()
+			continue
+		}
+		if d := a.Decoded(); d.Kind != test.kind || (d.Kind == adautil.Pointer && d.Pointer != ptr) {
+			t.Log("Decoded address mismatch", test.addr, d)
+			t.Fail()
+		}
+		// Reward addresses parse, but cannot receive payments.
+		if CheckADAAddress(test.addr, test.net) != (test.kind != adautil.Reward) {
+			t.Log("Check address mismatch", test.addr)
+			t.Fail()
+		}
+		if CheckAddress(test.addr, "ADA", test.net) != (test.kind != adautil.Reward) {
+			t.Log("Check address mismatch", test.addr)
+			t.Fail()
+		}
+		wrong := TestNet
+		if test.net == TestNet {
+			wrong = MainNet
+		}
+		if CheckADAAddress(test.addr, wrong) {
+			t.Log("Address accepted on wrong network", test.addr)
+			t.Fail()
+		}
+	}
+
+	if a, err := NewAddress("ADA", payment, MainNet); err != nil || a.String() != tests[3].addr {
+		t.Log("ADA address mismatch", a, err)
+		t.Fail()
+	}
+
+	// A base address whose payment credential is a script hash.
+	a, err := adautil.Decode("addr1zx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgsk7dx0j")
+	if err != nil || a.Kind != adautil.Base || !a.Payment.Script || a.Stake.Script || !bytes.Equal(a.Stake.Hash, adautil.KeyHash(stake)) {
+		t.Log("Script address mismatch", a, err)
+		t.Fail()
+	}
+
+	for _, addr := range []string{
+		"",
+		"addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl9",
+		"stake1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+		"cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60c",
+	} {
+		if CheckADAAddress(addr, MainNet) {
+			t.Log("Invalid address accepted", addr)
+			t.Fail()
+		}
+	}
+}
@@ -1,69 +1,400 @@
 package addressutil
 
 import (
+	"crypto/ed25519"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"regexp"
+	"strings"
+	"sync"
 
+	"github.com/suyhuai/addressutil/ecc"
+	"github.com/suyhuai/addressutil/util"
 	"github.com/suyhuai/addressutil/util/eosutil"
+	"github.com/suyhuai/addressutil/util/iostutil"
 )
 
-var ErrPublicKeyFormat = errors.New("public key format error")
+var (
+	ErrPublicKeyFormat = errors.New("public key format error")
+	ErrDuplicateChain  = util.ErrDuplicateChain
+	ErrAddressFormat   = errors.New("address format error")
+	ErrKeyType         = errors.New("key type not supported by chain")
+)
 
 type Address interface {
 	String() string
 	Url() string
 }
 
-func NewAddress(chain string, pubKey []byte, main bool) (addr Address, err error) {
+// builtinChains are the chains NewAddress and CheckAddress handle with a case
+// of their own.  They, and the chains of the EVM, Cosmos and Substrate
+// registries, are reserved so that util.Register refuses params for them.
+var builtinChains = []string{
+	"BTC", "OMNI", "BCH", "LTC", "DOGE", "DASH", "ZEC", "EOS", "IOST", "TRON",
+	"VDS", "XRP", "SOL", "ADA", "XLM", "ALGO", "XTZ",
+}
+
+// chainsMu guards the EVM, Cosmos and Substrate registries, which the
+// Register*Chain functions may extend while addresses are being derived.
+var chainsMu sync.RWMutex
+
+func init() {
+	reserved := append([]string(nil), builtinChains...)
+	for chain := range evmChains {
+		reserved = append(reserved, chain)
+	}
+	for chain := range cosmosChains {
+		reserved = append(reserved, chain)
+	}
+	for chain := range substrateChains {
+		reserved = append(reserved, chain)
+	}
+	for _, chain := range reserved {
+		if err := util.ReserveChain(chain); err != nil {
+			panic(fmt.Sprintf("reserve chain %s: %v", chain, err))
+		}
+	}
+}
+
+// NewAddress derives the address of pubKey on chain.  pubKey may be a
+// serialized secp256k1 public key in compressed, uncompressed or hybrid form,
+// its hex encoding, an *ecc.PublicKey or an *ecc.PrivateKey.  Chains using
+// ed25519 also accept an ecc.Ed25519PublicKey, an ecc.Ed25519PrivateKey or
+// their crypto/ed25519 counterparts; passing a key of a curve the chain does
+// not use fails with ErrKeyType.  Chain names are case-insensitive here and in
+// CheckAddress, ParseAddress and the Register*Chain functions.
+func NewAddress(chain string, pubKey interface{}, net Network) (addr Address, err error) {
+	chain = strings.ToUpper(chain)
 	switch chain {
 	case "BTC":
-		addr, err = NewBTCAddress(pubKey, main)
-	case "ETH":
-		addr, err = NewETHAddress(pubKey)
+		addr, err = NewBTCAddress(pubKey, net)
 	case "LTC":
-		addr, err = NewLTCAddress(pubKey, main)
+		addr, err = NewLTCAddress(pubKey, net)
 	case "BCH":
-		addr, err = NewBCHAddress(pubKey, main)
-	case "ETC":
-		addr, err = NewETHAddress(pubKey)
+		addr, err = NewBCHAddress(pubKey, net)
+	case "DOGE":
+		addr, err = NewDOGEAddress(pubKey, net)
+	case "DASH":
+		addr, err = NewDASHAddress(pubKey, net)
 	case "OMNI":
-		addr, err = NewBTCAddress(pubKey, main)
+		addr, err = NewBTCAddress(pubKey, net)
+	case "ZEC":
+		addr, err = NewZECAddress(pubKey, net)
 	case "TRON":
 		addr, err = NewTRONAddress(pubKey)
 	case "VDS":
-		addr, err = NewVDSAddress(pubKey)
+		addr, err = NewVDSAddress(pubKey, net)
+	case "XRP":
+		addr, err = NewXRPAddress(pubKey)
+	case "SOL":
+		addr, err = NewSOLAddress(pubKey)
+	case "ADA":
+		addr, err = NewADAAddress(pubKey, net)
+	case "XLM":
+		addr, err = NewXLMAddress(pubKey)
+	case "ALGO":
+		addr, err = NewALGOAddress(pubKey)
+	case "XTZ":
+		addr, err = NewXTZAddress(pubKey)
+	case "EOS":
+		addr, err = NewEOSAddress(pubKey)
+	case "IOST":
+		addr, err = NewIOSTAddress(pubKey)
 	default:
+		if c, ok := lookupEVMChain(chain); ok {
+			var chainID uint64
+			if chainID, err = c.checksumChainID(net); err != nil {
+				return
+			}
+			addr, err = NewETHAddress(pubKey, chainID)
+			return
+		}
+		if hrp, ok := lookupCosmosChain(chain); ok {
+			addr, err = NewCosmosAddress(pubKey, hrp)
+			return
+		}
+		if prefix, ok := lookupSubstrateChain(chain); ok {
+			addr, err = NewSubstrateAddress(pubKey, prefix)
+			return
+		}
+		if params, ok := lookupParams(chain, net); ok {
+			addr, err = newParamsAddress(pubKey, params)
+			return
+		}
 		err = fmt.Errorf("unsupport chain type %s", chain)
 	}
 
 	return
 }
 
-func CheckAddress(address, chain string, main bool) bool {
+// CheckOption adjusts which address encodings CheckAddress accepts.
+type CheckOption func(*checkOptions)
+
+type checkOptions struct {
+	icap     bool
+	offCurve bool
+	checksum bool
+}
+
+// WithICAP makes CheckAddress accept ICAP encoded ("XE…") ETH addresses in
+// addition to hex addresses.
+func WithICAP() CheckOption {
+	return func(o *checkOptions) {
+		o.icap = true
+	}
+}
+
+// WithChecksum makes CheckAddress reject mixed case EVM addresses whose EIP55
+// checksum is wrong.  Chains using EIP1191 always verify their checksum;
+// other chains accept any casing by default.
+func WithChecksum() CheckOption {
+	return func(o *checkOptions) {
+		o.checksum = true
+	}
+}
+
+// WithOffCurve makes CheckAddress accept Solana program derived addresses,
+// such as associated token accounts, in addition to wallet addresses.
+func WithOffCurve() CheckOption {
+	return func(o *checkOptions) {
+		o.offCurve = true
+	}
+}
+
+func CheckAddress(address, chain string, net Network, opts ...CheckOption) bool {
+	var o checkOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	chain = strings.ToUpper(chain)
 	switch chain {
 	case "BTC", "OMNI":
-		return CheckBTCAddress(address, main)
+		return CheckBTCAddress(address, net)
 	case "BCH":
-		return CheckBCHAddress(address, main)
+		return CheckBCHAddress(address, net)
 	case "LTC":
-		return CheckLTCAddress(address, main)
-	case "ETH", "ETC":
-		return CheckETHAddress(address)
+		return CheckLTCAddress(address, net)
+	case "DOGE":
+		return CheckDOGEAddress(address, net)
+	case "DASH":
+		return CheckDASHAddress(address, net)
+	case "ZEC":
+		return CheckZECAddress(address, net)
 	case "EOS":
 		return eosutil.CheckEOSAccount(address)
 	case "IOST":
-		m, _ := regexp.MatchString(`^([a-z0-9_]{5,11})$`, address)
-		return m
+		return iostutil.CheckAccount(address)
 	case "TRON":
 		return CheckTRONAddress(address)
 	case "VDS":
-		return CheckVDSAddress(address)
+		return CheckVDSAddress(address, net)
+	case "XRP":
+		return CheckXRPAddress(address, net)
+	case "SOL":
+		return CheckSOLAddress(address, o.offCurve)
+	case "ADA":
+		return CheckADAAddress(address, net)
+	case "XLM":
+		return CheckXLMAddress(address)
+	case "ALGO":
+		return CheckALGOAddress(address)
+	case "XTZ":
+		return CheckXTZAddress(address)
+	case "ETH":
+		if o.icap && isICAPAddress(address) {
+			return CheckICAPAddress(address)
+		}
+		c, _ := lookupEVMChain(chain)
+		return c.checkAddress(address, net, o.checksum)
 	default:
+		if c, ok := lookupEVMChain(chain); ok {
+			return c.checkAddress(address, net, o.checksum)
+		}
+		if hrp, ok := lookupCosmosChain(chain); ok {
+			return CheckCosmosAddress(address, hrp)
+		}
+		if prefix, ok := lookupSubstrateChain(chain); ok {
+			return CheckSubstrateAddress(address, prefix)
+		}
+		if params, ok := lookupParams(chain, net); ok {
+			return checkUTXOAddress(address, params)
+		}
 		return true
 	}
 }
 
+// ParseAddress checks address on chain and net like CheckAddress does and
+// returns it as an Address.  ADA reward addresses are the exception: they are
+// parsed although CheckAddress rejects them as payment targets.
+func ParseAddress(address, chain string, net Network, opts ...CheckOption) (Address, error) {
+	chain = strings.ToUpper(chain)
+	_, evm := lookupEVMChain(chain)
+	hrp, cosmos := lookupCosmosChain(chain)
+	prefix, substrate := lookupSubstrateChain(chain)
+	_, custom := lookupParams(chain, net)
+	switch chain {
+	case "BTC", "OMNI", "BCH", "LTC", "DOGE", "DASH", "ZEC", "EOS", "IOST", "TRON", "VDS", "XRP", "SOL", "ADA", "XLM", "ALGO", "XTZ":
+	default:
+		if !evm && !cosmos && !substrate && !custom {
+			return nil, fmt.Errorf("unsupport chain type %s", chain)
+		}
+	}
+	if chain == "ADA" {
+		return ParseADAAddress(address, net)
+	}
+	if !CheckAddress(address, chain, net, opts...) {
+		return nil, ErrAddressFormat
+	}
+
+	switch chain {
+	case "BTC", "OMNI":
+		return &BTCAddress{addr: address}, nil
+	case "BCH":
+		return &BCHAddress{addr: address}, nil
+	case "LTC":
+		return &LTCAddress{addr: address}, nil
+	case "DOGE":
+		return &DOGEAddress{addr: address}, nil
+	case "DASH":
+		return &DASHAddress{addr: address}, nil
+	case "ZEC":
+		return &ZECAddress{addr: address}, nil
+	case "EOS", "IOST":
+		return accountName(address), nil
+	case "TRON":
+		return ParseTRONAddress(address)
+	case "VDS":
+		return &VDSAddress{addr: address}, nil
+	case "XRP":
+		return ParseXRPAddress(address, net)
+	case "SOL":
+		return &SOLAddress{addr: address}, nil
+	case "XLM":
+		return ParseXLMAddress(address)
+	case "ALGO":
+		return &ALGOAddress{addr: address}, nil
+	case "XTZ":
+		return &XTZAddress{addr: address}, nil
+	}
+	if cosmos {
+		return &CosmosAddress{hrp: hrp, addr: address}, nil
+	}
+	if substrate {
+		return &SubstrateAddress{prefix: prefix, addr: address}, nil
+	}
+	if custom {
+		return &BTCAddress{addr: address}, nil
+	}
+	return &ETHAddress{addr: address}, nil
+}
+
+// accountName is the Address of chains where accounts are registered names
+// rather than derived from a public key.
+type accountName string
+
+func (a accountName) String() string {
+	return string(a)
+}
+
+func (a accountName) Url() string {
+	return a.String()
+}
+
 func AddressUrl(address, _chain string) string {
 	return address
 }
+
+// parsePubKey normalises the public key inputs accepted by the address
+// constructors.  It returns the parsed key together with its serialization:
+// byte and hex inputs keep the format they were given in, since the hash based
+// addresses differ between compressed and uncompressed keys, while key values
+// serialize compressed.
+func parsePubKey(key interface{}) (*ecc.PublicKey, []byte, error) {
+	var serialized []byte
+	switch k := key.(type) {
+	case []byte:
+		serialized = k
+	case string:
+		k = strings.TrimPrefix(strings.TrimPrefix(k, "0x"), "0X")
+		b, err := hex.DecodeString(k)
+		if err != nil {
+			return nil, nil, ErrPublicKeyFormat
+		}
+		serialized = b
+	case *ecc.PublicKey:
+		if k == nil {
+			return nil, nil, ErrPublicKeyFormat
+		}
+		return k, k.SerializeCompressed(), nil
+	case *ecc.PrivateKey:
+		if k == nil {
+			return nil, nil, ErrPublicKeyFormat
+		}
+		return k.PubKey(), k.PubKey().SerializeCompressed(), nil
+	case ecc.Ed25519PublicKey, ecc.Ed25519PrivateKey, ed25519.PublicKey, ed25519.PrivateKey:
+		return nil, nil, ErrKeyType
+	default:
+		return nil, nil, ErrPublicKeyFormat
+	}
+
+	pub, err := ecc.ParsePubKey(serialized, ecc.S256())
+	if err != nil {
+		return nil, nil, ErrPublicKeyFormat
+	}
+	return pub, serialized, nil
+}
+
+// ed25519Key returns the public key of key if it is an ed25519 key value.
+// Serialized keys are not considered, since a 32 byte string does not tell
+// its curve.
+func ed25519Key(key interface{}) (ed25519.PublicKey, bool) {
+	var pub []byte
+	switch k := key.(type) {
+	case ecc.Ed25519PublicKey:
+		pub = k
+	case ed25519.PublicKey:
+		pub = k
+	case ecc.Ed25519PrivateKey:
+		if len(k) != ed25519.PrivateKeySize {
+			return nil, false
+		}
+		pub = k.PubKey()
+	case ed25519.PrivateKey:
+		if len(k) != ed25519.PrivateKeySize {
+			return nil, false
+		}
+		pub = k.Public().(ed25519.PublicKey)
+	default:
+		return nil, false
+	}
+	if len(pub) != ed25519.PublicKeySize {
+		return nil, false
+	}
+	return ed25519.PublicKey(pub), true
+}
+
+// parseEd25519PubKey normalises the public key inputs of chains that only use
+// ed25519: ed25519 key values and raw or hex encoded 32 byte keys.  Keys of
+// other curves fail with ErrKeyType.
+func parseEd25519PubKey(key interface{}) (ed25519.PublicKey, error) {
+	if pub, ok := ed25519Key(key); ok {
+		return pub, nil
+	}
+
+	var raw []byte
+	switch k := key.(type) {
+	case []byte:
+		raw = k
+	case string:
+		raw, _ = hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(k, "0x"), "0X"))
+	}
+	if len(raw) == ed25519.PublicKeySize {
+		return append(ed25519.PublicKey(nil), raw...), nil
+	}
+
+	if _, _, err := parsePubKey(key); err == nil {
+		return nil, ErrKeyType
+	}
+	return nil, ErrPublicKeyFormat
+}
@@ -0,0 +1,95 @@
+package addressutil
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/suyhuai/addressutil/ecc"
+)
+
+func TestNewAddressKeyFormats(t *testing.T) {
+	priv, _ := hex.DecodeString("ef7cc0fcd4acb523decd3c7b0ddc78ff620f6a957e1da51aff0b5f160c84e975")
+	privKey, pubKey := ecc.PrivKeyFromBytes(ecc.S256(), priv)
+
+	keys := []interface{}{
+		pubKey.SerializeUncompressed(),
+		pubKey.SerializeCompressed(),
+		hex.EncodeToString(pubKey.SerializeCompressed()),
+		"0x" + hex.EncodeToString(pubKey.SerializeUncompressed()),
+		pubKey,
+		privKey,
+	}
+
+	for _, chain := range []string{"ETH", "TRON", "VDS"} {
+		want, err := NewAddress(chain, pubKey.SerializeUncompressed(), MainNet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, key := range keys {
+			if a, err := NewAddress(chain, key, MainNet); err != nil {
+				t.Log(chain, err)
+				t.Fail()
+			} else if a.String() != want.String() {
+				t.Log("Address mismatch", chain, a, want)
+				t.Fail()
+			}
+		}
+	}
+}
+
+func TestNewAddressInvalidKey(t *testing.T) {
+	keys := []interface{}{
+		[]byte{},
+		[]byte{0x04, 0x01, 0x02},
+		"not a key",
+		(*ecc.PublicKey)(nil),
+		42,
+	}
+
+	for _, chain := range []string{"BTC", "LTC", "BCH", "DOGE", "DASH", "ZEC", "ETH", "TRON", "VDS", "XRP", "ATOM", "XTZ"} {
+		for _, key := range keys {
+			if _, err := NewAddress(chain, key, MainNet); err != ErrPublicKeyFormat {
+				t.Log("unexpected error", chain, key, err)
+				t.Fail()
+			}
+		}
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	addresses := []struct {
+		chain   string
+		net     Network
+		address string
+	}{
+		{"BTC", RegTest, "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080"},
+		{"LTC", MainNet, "LdNQoxcHSqEX6jLpRH6V5op12uF9KE5KYY"},
+		{"DOGE", MainNet, "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE"},
+		{"DASH", TestNet, "yWziQMcwmKjRdzi7eWjwiQX8EjWcd6dSg6"},
+		{"ZEC", MainNet, "t1UYsZVJkLPeMjxEtACvSxfWuNmddpWfxzs"},
+		{"ADA", MainNet, "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+		{"TRON", MainNet, "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL"},
+		{"EOS", MainNet, "eosio.token"},
+		{"ETH", MainNet, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+	}
+
+	for _, c := range addresses {
+		a, err := ParseAddress(c.address, c.chain, c.net)
+		if err != nil {
+			t.Log("parse address fail: ", c.chain, c.address, err)
+			t.Fail()
+		} else if a.String() != c.address {
+			t.Log("Address mismatch", a, c.address)
+			t.Fail()
+		}
+	}
+
+	if _, err := ParseAddress("bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080", "BTC", MainNet); err != ErrAddressFormat {
+		t.Log("unexpected error: ", err)
+		t.Fail()
+	}
+	if _, err := ParseAddress("anything", "XYZ", MainNet); err == nil {
+		t.Log("unknown chain accepted")
+		t.Fail()
+	}
+}
@@ -0,0 +1,49 @@
+package addressutil
+
+import (
+	"github.com/suyhuai/addressutil/util/algoutil"
+)
+
+// ALGOAddress is an Algorand address, the encoding of an ed25519 account key
+// or of the hash of an application ID.
+type ALGOAddress struct {
+	addr   string
+	pubKey []byte
+}
+
+// NewALGOAddress returns the address of the ed25519 key pubKey.
+func NewALGOAddress(pubKey interface{}) (*ALGOAddress, error) {
+	pub, err := parseEd25519PubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := algoutil.EncodeAddress(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ALGOAddress{
+		addr:   addr,
+		pubKey: pub,
+	}, nil
+}
+
+// NewALGOApplicationAddress returns the escrow address of the application
+// appID, which holds the application's funds.
+func NewALGOApplicationAddress(appID uint64) *ALGOAddress {
+	return &ALGOAddress{addr: algoutil.ApplicationAddress(appID)}
+}
+
+func (a *ALGOAddress) String() string {
+	return a.addr
+}
+
+func (a *ALGOAddress) Url() string {
+	return a.String()
+}
+
+func CheckALGOAddress(address string) bool {
+	_, err := algoutil.DecodeAddress(address)
+	return err == nil
+}
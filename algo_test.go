@@ -0,0 +1,55 @@
+package addressutil
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestALGOAddress(t *testing.T) {
+	tests := []struct {
+		pubKey string
+		addr   string
+	}{
+		{"0000000000000000000000000000000000000000000000000000000000000000", "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ"},
+		{"a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed", "USZIK276YUIKXK4JOU72YGWA4EISGZHH2JIFIWLD6E27FIZRRDWSLIMC4M"},
+	}
+
+	for _, test := range tests {
+		pub, _ := hex.DecodeString(test.pubKey)
+		if a, err := NewAddress("ALGO", pub, MainNet); err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if a.String() != test.addr {
+			t.Log("Address mismatch", a, test.addr)
+			t.Fail()
+		}
+		if !CheckAddress(test.addr, "ALGO", MainNet) {
+			t.Log("Address check failed", test.addr)
+			t.Fail()
+		}
+	}
+
+	apps := map[uint64]string{
+		1:   "WCS6TVPJRBSARHLN2326LRU5BYVJZUKI2VJ53CAWKYYHDE455ZGKANWMGM",
+		123: "WRBMNT66ECE2AOYKM76YVWIJMBW6Z3XCQZOKG5BL7NISAQC2LBGEKTZLRM",
+	}
+	for appID, addr := range apps {
+		if a := NewALGOApplicationAddress(appID); a.String() != addr {
+			t.Log("Application address mismatch", appID, a, addr)
+			t.Fail()
+		}
+	}
+
+	for _, addr := range []string{
+		"",
+		"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKA",
+		"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFK",
+		"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaay5hfkq",
+		"GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ",
+	} {
+		if CheckAddress(addr, "ALGO", MainNet) {
+			t.Log("Invalid address accepted", addr)
+			t.Fail()
+		}
+	}
+}
@@ -13,14 +13,67 @@ import (
 var bigRadix = big.NewInt(58)
 var bigZero = big.NewInt(0)
 
+// Alphabet is a base58 alphabet: the 58 characters digits are encoded as, in
+// order of value.
+type Alphabet struct {
+	encode string
+	decode [256]byte
+}
+
+var (
+	// BitcoinAlphabet is the modified base58 alphabet used by Bitcoin.  The
+	// package level functions use it.
+	BitcoinAlphabet = &Alphabet{encode: alphabet, decode: b58}
+
+	// RippleAlphabet is the base58 alphabet used by the XRP Ledger.
+	RippleAlphabet = NewAlphabet("rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz")
+
+	// FlickrAlphabet is the base58 alphabet used by Flickr short URLs.
+	FlickrAlphabet = NewAlphabet("123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ")
+)
+
+// NewAlphabet returns the Alphabet whose digits are the characters of s.  It
+// panics unless s consists of 58 distinct ASCII characters.
+func NewAlphabet(s string) *Alphabet {
+	if len(s) != 58 {
+		panic("base58: alphabet must be 58 characters long")
+	}
+	a := &Alphabet{encode: s}
+	for i := range a.decode {
+		a.decode[i] = 255
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 128 || a.decode[s[i]] != 255 {
+			panic("base58: alphabet must consist of distinct ASCII characters")
+		}
+		a.decode[s[i]] = byte(i)
+	}
+	return a
+}
+
+// String returns the characters of the alphabet.
+func (a *Alphabet) String() string {
+	return a.encode
+}
+
 // Decode decodes a modified base58 string to a byte slice.
 func Decode(b string) []byte {
+	return BitcoinAlphabet.Decode(b)
+}
+
+// Encode encodes a byte slice to a modified base58 string.
+func Encode(b []byte) string {
+	return BitcoinAlphabet.Encode(b)
+}
+
+// Decode decodes a base58 string in the alphabet a to a byte slice.
+func (a *Alphabet) Decode(b string) []byte {
 	answer := big.NewInt(0)
 	j := big.NewInt(1)
 
 	scratch := new(big.Int)
 	for i := len(b) - 1; i >= 0; i-- {
-		tmp := b58[b[i]]
+		tmp := a.decode[b[i]]
 		if tmp == 255 {
 			return []byte("")
 		}
@@ -34,7 +87,7 @@ func Decode(b string) []byte {
 
 	var numZeros int
 	for numZeros = 0; numZeros < len(b); numZeros++ {
-		if b[numZeros] != alphabetIdx0 {
+		if b[numZeros] != a.encode[0] {
 			break
 		}
 	}
@@ -45,8 +98,8 @@ func Decode(b string) []byte {
 	return val
 }
 
-// Encode encodes a byte slice to a modified base58 string.
-func Encode(b []byte) string {
+// Encode encodes a byte slice to a base58 string in the alphabet a.
+func (a *Alphabet) Encode(b []byte) string {
 	x := new(big.Int)
 	x.SetBytes(b)
 
@@ -54,7 +107,7 @@ func Encode(b []byte) string {
 	for x.Cmp(bigZero) > 0 {
 		mod := new(big.Int)
 		x.DivMod(x, bigRadix, mod)
-		answer = append(answer, alphabet[mod.Int64()])
+		answer = append(answer, a.encode[mod.Int64()])
 	}
 
 	// leading zero bytes
@@ -62,7 +115,7 @@ func Encode(b []byte) string {
 		if i != 0 {
 			break
 		}
-		answer = append(answer, alphabetIdx0)
+		answer = append(answer, a.encode[0])
 	}
 
 	// reverse
@@ -5,6 +5,7 @@
 package base58
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"errors"
 )
@@ -16,6 +17,10 @@ var ErrChecksum = errors.New("checksum error")
 // ErrInvalidFormat indicates that the check-encoded string has an invalid format.
 var ErrInvalidFormat = errors.New("invalid format: version and/or checksum bytes missing")
 
+// ErrUnknownPrefix indicates that the check-encoded string carries none of the expected version
+// prefixes.
+var ErrUnknownPrefix = errors.New("unknown version prefix")
+
 // checksum: first four bytes of sha256^2
 func checksum(input []byte) (cksum [4]byte) {
 	h := sha256.Sum256(input)
@@ -26,17 +31,47 @@ func checksum(input []byte) (cksum [4]byte) {
 
 // CheckEncode prepends a version byte and appends a four byte checksum.
 func CheckEncode(input []byte, version byte) string {
-	b := make([]byte, 0, 1+len(input)+4)
-	b = append(b, version)
+	return BitcoinAlphabet.CheckEncode(input, version)
+}
+
+// CheckEncodePrefix prepends a version prefix of any length and appends a four byte checksum.
+func CheckEncodePrefix(input []byte, prefix []byte) string {
+	return BitcoinAlphabet.CheckEncodePrefix(input, prefix)
+}
+
+// CheckDecode decodes a string that was encoded with CheckEncode and verifies the checksum.
+func CheckDecode(input string) (result []byte, version byte, err error) {
+	return BitcoinAlphabet.CheckDecode(input)
+}
+
+// CheckDecodePrefix decodes a string that was encoded with CheckEncodePrefix using one of prefixes
+// and verifies the checksum.  It returns the payload together with the longest of prefixes the
+// string starts with, so the result does not depend on the order of prefixes.
+func CheckDecodePrefix(input string, prefixes ...[]byte) (result []byte, prefix []byte, err error) {
+	return BitcoinAlphabet.CheckDecodePrefix(input, prefixes...)
+}
+
+// CheckEncode prepends a version byte, appends a four byte checksum and encodes the result in the
+// alphabet a.
+func (a *Alphabet) CheckEncode(input []byte, version byte) string {
+	return a.CheckEncodePrefix(input, []byte{version})
+}
+
+// CheckEncodePrefix prepends a version prefix of any length, appends a four byte checksum and
+// encodes the result in the alphabet a.
+func (a *Alphabet) CheckEncodePrefix(input []byte, prefix []byte) string {
+	b := make([]byte, 0, len(prefix)+len(input)+4)
+	b = append(b, prefix...)
 	b = append(b, input[:]...)
 	cksum := checksum(b)
 	b = append(b, cksum[:]...)
-	return Encode(b)
+	return a.Encode(b)
 }
 
-// CheckDecode decodes a string that was encoded with CheckEncode and verifies the checksum.
-func CheckDecode(input string) (result []byte, version byte, err error) {
-	decoded := Decode(input)
+// CheckDecode decodes a string that was encoded with the CheckEncode of a and verifies the
+// checksum.
+func (a *Alphabet) CheckDecode(input string) (result []byte, version byte, err error) {
+	decoded := a.Decode(input)
 	if len(decoded) < 5 {
 		return nil, 0, ErrInvalidFormat
 	}
@@ -50,3 +85,29 @@ func CheckDecode(input string) (result []byte, version byte, err error) {
 	result = append(result, payload...)
 	return
 }
+
+// CheckDecodePrefix decodes a string that was encoded with the CheckEncodePrefix of a using one of
+// prefixes and verifies the checksum.  The longest matching prefix wins.
+func (a *Alphabet) CheckDecodePrefix(input string, prefixes ...[]byte) (result []byte, prefix []byte, err error) {
+	decoded := a.Decode(input)
+	if len(decoded) < 4 {
+		return nil, nil, ErrInvalidFormat
+	}
+	var cksum [4]byte
+	copy(cksum[:], decoded[len(decoded)-4:])
+	if checksum(decoded[:len(decoded)-4]) != cksum {
+		return nil, nil, ErrChecksum
+	}
+	body := decoded[:len(decoded)-4]
+	found := false
+	for _, p := range prefixes {
+		if len(body) > len(p) && bytes.Equal(body[:len(p)], p) && (!found || len(p) > len(prefix)) {
+			prefix, found = p, true
+		}
+	}
+	if !found {
+		return nil, nil, ErrUnknownPrefix
+	}
+	result = append(result, body[len(prefix):]...)
+	return result, prefix, nil
+}
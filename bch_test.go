@@ -13,7 +13,7 @@ func TestBCHAddress(t *testing.T) {
 	}
 
 	for addr, pubKey := range keys {
-		if a, err := NewBCHAddress(pubKey, true); err != nil {
+		if a, err := NewBCHAddress(pubKey, MainNet); err != nil {
 			t.Log(err)
 			t.Fail()
 		} else if a.String() != addr {
@@ -37,13 +37,33 @@ func TestCheckBCHAddress(t *testing.T) {
 	}
 
 	for _, address := range validAddresses {
-		if !CheckBCHAddress(address, true) {
+		if !CheckBCHAddress(address, MainNet) {
 			t.Fail()
 		}
 	}
 
 	for _, address := range invalidAddresses {
-		if CheckBCHAddress(address, true) {
+		if CheckBCHAddress(address, MainNet) {
+			t.Fail()
+		}
+	}
+}
+
+func TestBCHAddressNetworks(t *testing.T) {
+	pubKey := []byte{0x02, 0x79, 0xbe, 0x66, 0x7e, 0xf9, 0xdc, 0xbb, 0xac, 0x55, 0xa0, 0x62, 0x95, 0xce, 0x87, 0x0b, 0x07, 0x02, 0x9b, 0xfc, 0xdb, 0x2d, 0xce, 0x28, 0xd9, 0x59, 0xf2, 0x81, 0x5b, 0x16, 0xf8, 0x17, 0x98}
+
+	for _, net := range []Network{TestNet4, ChipNet} {
+		a, err := NewBCHAddress(pubKey, net)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cash := a.String()
+		if !CheckBCHAddress(cash, net) {
+			t.Log("check address fail: ", net, cash)
+			t.Fail()
+		}
+		if CheckBCHAddress(cash, MainNet) {
+			t.Log("address accepted on mainnet: ", net, cash)
 			t.Fail()
 		}
 	}
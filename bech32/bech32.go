@@ -13,13 +13,66 @@ const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
 
 var gen = []int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
 
+// Version identifies the checksum variant of a bech32 string.
+type Version int
+
+const (
+	// Version0 is the original bech32 checksum defined in BIP 173.
+	Version0 Version = iota
+
+	// VersionM is the bech32m checksum defined in BIP 350.
+	VersionM
+)
+
+// constant returns the value the checksum polymod is xored with for v.
+func (v Version) constant() int {
+	if v == VersionM {
+		return 0x2bc830a3
+	}
+	return 1
+}
+
 // Decode decodes a bech32 encoded string, returning the human-readable
 // part and the data part excluding the checksum.
 func Decode(bech string) (string, []byte, error) {
+	return decode(bech, 90, Version0)
+}
+
+// DecodeM decodes a bech32m encoded string, returning the human-readable
+// part and the data part excluding the checksum.
+func DecodeM(bech string) (string, []byte, error) {
+	return decode(bech, 90, VersionM)
+}
+
+// DecodeWithLimit decodes a bech32 encoded string of at most limit
+// characters.  Formats such as Cardano addresses are longer than the 90
+// characters BIP 173 allows.
+func DecodeWithLimit(bech string, limit int) (string, []byte, error) {
+	return decode(bech, limit, Version0)
+}
+
+// DecodeNoLimit decodes a bech32 or bech32m encoded string of any length and
+// reports which checksum it carries.  Formats such as Zcash unified addresses
+// are longer than the 90 characters BIP 173 allows.
+func DecodeNoLimit(bech string) (string, []byte, Version, error) {
+	hrp, data, err := decode(bech, -1, VersionM)
+	if err == nil {
+		return hrp, data, VersionM, nil
+	}
+	hrp, data, err = decode(bech, -1, Version0)
+	if err != nil {
+		return "", nil, Version0, err
+	}
+	return hrp, data, Version0, nil
+}
+
+// decode decodes bech, which may be at most limit characters long unless
+// limit is negative, and verifies its checksum against version v.
+func decode(bech string, limit int, v Version) (string, []byte, error) {
 	// The maximum allowed length for a bech32 string is 90. It must also
 	// be at least 8 characters, since it needs a non-empty HRP, a
 	// separator, and a 6 character checksum.
-	if len(bech) < 8 || len(bech) > 90 {
+	if len(bech) < 8 || (limit >= 0 && len(bech) > limit) {
 		return "", nil, fmt.Errorf("invalid bech32 string length %d",
 			len(bech))
 	}
@@ -63,11 +116,11 @@ func Decode(bech string) (string, []byte, error) {
 			"%v", err)
 	}
 
-	if !bech32VerifyChecksum(hrp, decoded) {
+	if !bech32VerifyChecksum(hrp, decoded, v) {
 		moreInfo := ""
 		checksum := bech[len(bech)-6:]
 		expected, err := toChars(bech32Checksum(hrp,
-			decoded[:len(decoded)-6]))
+			decoded[:len(decoded)-6], v))
 		if err == nil {
 			moreInfo = fmt.Sprintf("Expected %v, got %v.",
 				expected, checksum)
@@ -83,8 +136,19 @@ func Decode(bech string) (string, []byte, error) {
 // human-readable part hrb. Note that the bytes must each encode 5 bits
 // (base32).
 func Encode(hrp string, data []byte) (string, error) {
+	return encode(hrp, data, Version0)
+}
+
+// EncodeM encodes a byte slice into a bech32m string with the
+// human-readable part hrp. Note that the bytes must each encode 5 bits
+// (base32).
+func EncodeM(hrp string, data []byte) (string, error) {
+	return encode(hrp, data, VersionM)
+}
+
+func encode(hrp string, data []byte, v Version) (string, error) {
 	// Calculate the checksum of the data and append it at the end.
-	checksum := bech32Checksum(hrp, data)
+	checksum := bech32Checksum(hrp, data, v)
 	combined := append(data, checksum...)
 
 	// The resulting bech32 string is the concatenation of the hrp, the
@@ -195,8 +259,9 @@ func ConvertBits(data []byte, fromBits, toBits uint8, pad bool) ([]byte, error)
 	return regrouped, nil
 }
 
-// For more details on the checksum calculation, please refer to BIP 173.
-func bech32Checksum(hrp string, data []byte) []byte {
+// For more details on the checksum calculation, please refer to BIP 173 and
+// BIP 350.
+func bech32Checksum(hrp string, data []byte, v Version) []byte {
 	// Convert the bytes to list of integers, as this is needed for the
 	// checksum calculation.
 	integers := make([]int, len(data))
@@ -205,7 +270,7 @@ func bech32Checksum(hrp string, data []byte) []byte {
 	}
 	values := append(bech32HrpExpand(hrp), integers...)
 	values = append(values, []int{0, 0, 0, 0, 0, 0}...)
-	polymod := bech32Polymod(values) ^ 1
+	polymod := bech32Polymod(values) ^ v.constant()
 	var res []byte
 	for i := 0; i < 6; i++ {
 		res = append(res, byte((polymod>>uint(5*(5-i)))&31))
@@ -241,12 +306,13 @@ func bech32HrpExpand(hrp string) []int {
 	return v
 }
 
-// For more details on the checksum verification, please refer to BIP 173.
-func bech32VerifyChecksum(hrp string, data []byte) bool {
+// For more details on the checksum verification, please refer to BIP 173 and
+// BIP 350.
+func bech32VerifyChecksum(hrp string, data []byte, v Version) bool {
 	integers := make([]int, len(data))
 	for i, b := range data {
 		integers[i] = int(b)
 	}
 	concat := append(bech32HrpExpand(hrp), integers...)
-	return bech32Polymod(concat) == 1
+	return bech32Polymod(concat) == v.constant()
 }
@@ -1,38 +1,46 @@
 package addressutil
 
 import (
-	"crypto/sha256"
-	"github.com/suyhuai/addressutil/base58"
+	"github.com/suyhuai/addressutil/hash160"
 	"github.com/suyhuai/addressutil/util"
-	"github.com/suyhuai/addressutil/util/btcutil"
 	"github.com/suyhuai/addressutil/util/btcutil/chaincfg"
-	"golang.org/x/crypto/ripemd160"
+	"github.com/suyhuai/addressutil/util/utxoutil"
 )
 
+// BTCNet is the base58 version byte of a Bitcoin P2PKH address.
+//
+// Deprecated: networks are selected by Network, their address magics live in
+// the chaincfg params.
 type BTCNet uint8
 
+// Deprecated: use Network and chaincfg.MainNetParams.
 const BTC_MAIN_NET BTCNet = 0x00
+
+// Deprecated: use Network and chaincfg.TestNet3Params.
 const BTC_TEST_NET BTCNet = 0x6f
 
 type BTCAddress struct {
 	Address
 
-	net    BTCNet
+	params *util.Params
 	addr   string
 	pubKey []byte
 }
 
-func NewBTCAddress(pubKey []byte, main bool) (*BTCAddress, error) {
-	var net BTCNet
-	if main {
-		net = BTC_MAIN_NET
-	} else {
-		net = BTC_TEST_NET
+func NewBTCAddress(pubKey interface{}, net Network) (*BTCAddress, error) {
+	_, serialized, err := parsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := btcNetParams(net)
+	if err != nil {
+		return nil, err
 	}
 
 	return &BTCAddress{
-		net:    net,
-		pubKey: pubKey,
+		params: params,
+		pubKey: serialized,
 	}, nil
 }
 
@@ -41,12 +49,12 @@ func (a *BTCAddress) String() string {
 		return a.addr
 	}
 
-	h1 := sha256.Sum256(a.pubKey)
-	hash := ripemd160.New()
-	hash.Write(h1[:])
-	h2 := hash.Sum(nil)
+	addr, err := utxoutil.PayToPubKeyHash(hash160.Hash160(a.pubKey), a.params)
+	if err != nil {
+		return ""
+	}
 
-	a.addr = base58.CheckEncode(h2[:], byte(a.net))
+	a.addr = addr.EncodeAddress()
 	return a.addr
 }
 
@@ -54,16 +62,30 @@ func (a *BTCAddress) Url() string {
 	return a.String()
 }
 
-func CheckBTCAddress(address string, main bool) bool {
-	var netParam *util.Params
-	if main {
-		netParam = &chaincfg.MainNetParams
-	} else {
-		netParam = &chaincfg.TestNet3Params
-	}
-	addr, err := btcutil.DecodeAddress(address, netParam)
+func CheckBTCAddress(address string, net Network) bool {
+	params, err := btcNetParams(net)
 	if err != nil {
 		return false
 	}
-	return addr.IsForNet(netParam)
+	return checkUTXOAddress(address, params)
+}
+
+// btcNetParams returns the chain parameters of the Bitcoin network net.
+func btcNetParams(net Network) (*util.Params, error) {
+	switch net {
+	case MainNet:
+		return &chaincfg.MainNetParams, nil
+	case TestNet:
+		return &chaincfg.TestNet3Params, nil
+	case TestNet4:
+		return &chaincfg.TestNet4Params, nil
+	case SigNet:
+		return &chaincfg.SigNetParams, nil
+	case RegTest:
+		return &chaincfg.RegressionNetParams, nil
+	case SimNet:
+		return &chaincfg.SimNetParams, nil
+	default:
+		return nil, ErrUnsupportedNetwork
+	}
 }
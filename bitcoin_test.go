@@ -2,6 +2,11 @@ package addressutil
 
 import (
 	"testing"
+
+	"github.com/suyhuai/addressutil/util"
+	"github.com/suyhuai/addressutil/util/btcutil"
+	"github.com/suyhuai/addressutil/util/btcutil/chaincfg"
+	"github.com/suyhuai/addressutil/util/utxoutil"
 )
 
 func TestBitcoinAddress(t *testing.T) {
@@ -13,7 +18,7 @@ func TestBitcoinAddress(t *testing.T) {
 	}
 
 	for addr, pubKey := range keys {
-		if a, err := NewBTCAddress(pubKey, true); err != nil {
+		if a, err := NewBTCAddress(pubKey, MainNet); err != nil {
 			t.Log(err)
 			t.Fail()
 		} else if a.String() != addr {
@@ -22,3 +27,68 @@ func TestBitcoinAddress(t *testing.T) {
 		}
 	}
 }
+
+func TestBTCAddressNetworks(t *testing.T) {
+	pubKey := []byte{0x02, 0x79, 0xbe, 0x66, 0x7e, 0xf9, 0xdc, 0xbb, 0xac, 0x55, 0xa0, 0x62, 0x95, 0xce, 0x87, 0x0b, 0x07, 0x02, 0x9b, 0xfc, 0xdb, 0x2d, 0xce, 0x28, 0xd9, 0x59, 0xf2, 0x81, 0x5b, 0x16, 0xf8, 0x17, 0x98}
+
+	for _, net := range []Network{TestNet, TestNet4, SigNet, RegTest, SimNet} {
+		a, err := NewBTCAddress(pubKey, net)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !CheckBTCAddress(a.String(), net) {
+			t.Log("check address fail: ", net, a)
+			t.Fail()
+		}
+		if CheckBTCAddress(a.String(), MainNet) {
+			t.Log("address accepted on mainnet: ", net, a)
+			t.Fail()
+		}
+	}
+
+	if !CheckAddress("bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080", "BTC", RegTest) {
+		t.Log("check regtest segwit address fail")
+		t.Fail()
+	}
+	if CheckAddress("bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080", "BTC", TestNet) {
+		t.Log("regtest segwit address accepted on testnet")
+		t.Fail()
+	}
+
+	if _, err := NewBTCAddress(pubKey, ChipNet); err != ErrUnsupportedNetwork {
+		t.Log("unexpected error: ", err)
+		t.Fail()
+	}
+}
+
+func TestBTCSigNetTestNet4(t *testing.T) {
+	genesis := map[string]string{
+		chaincfg.SigNetParams.Name:   "00000008819873e925422c1ff0f99f7cc9bbb232af63a077a480a3633bee1ef6",
+		chaincfg.TestNet4Params.Name: "00000000da84f2bafbbc53dee25a72ae507ff4914b867c565be350b0da8bf043",
+	}
+	for _, params := range []*util.Params{&chaincfg.SigNetParams, &chaincfg.TestNet4Params} {
+		if params.GenesisHash.String() != genesis[params.Name] {
+			t.Log("genesis hash mismatch", params.Name, params.GenesisHash)
+			t.Fail()
+		}
+	}
+
+	for _, net := range []Network{SigNet, TestNet4} {
+		if !CheckBTCAddress("tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", net) {
+			t.Log("check segwit address fail: ", net)
+			t.Fail()
+		}
+	}
+}
+
+func TestBTCUtilDecodeAddress(t *testing.T) {
+	// The deprecated btcutil codec forwards to utxoutil.
+	addr, err := btcutil.DecodeAddress("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := addr.(*utxoutil.AddressWitnessPubKeyHash); !ok || !addr.IsForNet(&chaincfg.MainNetParams) {
+		t.Log("decode address fail: ", addr)
+		t.Fail()
+	}
+}
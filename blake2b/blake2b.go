@@ -0,0 +1,198 @@
+// Package blake2b implements the BLAKE2b hash algorithm defined by RFC 7693,
+// including the salt-free personalization that several chains use for domain
+// separation.
+package blake2b
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/bits"
+)
+
+const (
+	// The block size of the hash algorithm in bytes.
+	BlockSize = 128
+
+	// The maximum size of a digest in bytes.
+	Size = 64
+
+	// The size of a personalization string in bytes.
+	PersonalSize = 16
+)
+
+var (
+	ErrSize     = errors.New("blake2b: invalid digest size")
+	ErrPersonal = errors.New("blake2b: personalization longer than 16 bytes")
+)
+
+var iv = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+var sigma = [12][16]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+}
+
+type digest struct {
+	h        [8]uint64
+	t        [2]uint64
+	x        [BlockSize]byte
+	nx       int
+	size     int
+	personal [PersonalSize]byte
+}
+
+// New returns a hash.Hash computing the size byte BLAKE2b digest personalised
+// with personal, which is zero padded to 16 bytes.
+func New(size int, personal []byte) (hash.Hash, error) {
+	if size < 1 || size > Size {
+		return nil, ErrSize
+	}
+	if len(personal) > PersonalSize {
+		return nil, ErrPersonal
+	}
+	d := &digest{size: size}
+	copy(d.personal[:], personal)
+	d.Reset()
+	return d, nil
+}
+
+// Sum returns the size byte BLAKE2b digest of data personalised with personal.
+func Sum(data []byte, size int, personal []byte) ([]byte, error) {
+	h, err := New(size, personal)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(data)
+	return h.Sum(nil), nil
+}
+
+// Sum160 returns the 20 byte BLAKE2b digest of data.
+func Sum160(data []byte) []byte {
+	sum, _ := Sum(data, 20, nil)
+	return sum
+}
+
+// Sum224 returns the 28 byte BLAKE2b digest of data.
+func Sum224(data []byte) []byte {
+	sum, _ := Sum(data, 28, nil)
+	return sum
+}
+
+// Sum256 returns the 32 byte BLAKE2b digest of data.
+func Sum256(data []byte) []byte {
+	sum, _ := Sum(data, 32, nil)
+	return sum
+}
+
+// Sum512 returns the 64 byte BLAKE2b digest of data.
+func Sum512(data []byte) []byte {
+	sum, _ := Sum(data, 64, nil)
+	return sum
+}
+
+func (d *digest) Reset() {
+	d.h = iv
+	d.h[0] ^= uint64(d.size) | 1<<16 | 1<<24
+	d.h[6] ^= binary.LittleEndian.Uint64(d.personal[0:8])
+	d.h[7] ^= binary.LittleEndian.Uint64(d.personal[8:16])
+	d.t = [2]uint64{}
+	d.nx = 0
+}
+
+func (d *digest) Size() int { return d.size }
+
+func (d *digest) BlockSize() int { return BlockSize }
+
+func (d *digest) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		// The last block is compressed with the final flag set, so a full
+		// buffer is only flushed once more input arrives.
+		if d.nx == BlockSize {
+			d.increment(BlockSize)
+			d.compress(false)
+			d.nx = 0
+		}
+		c := copy(d.x[d.nx:], p)
+		d.nx += c
+		p = p[c:]
+	}
+	return n, nil
+}
+
+func (d *digest) Sum(in []byte) []byte {
+	// Make a copy of d so that caller can keep writing and summing.
+	d0 := *d
+	d0.increment(uint64(d0.nx))
+	for i := d0.nx; i < BlockSize; i++ {
+		d0.x[i] = 0
+	}
+	d0.compress(true)
+
+	var out [Size]byte
+	for i, v := range d0.h {
+		binary.LittleEndian.PutUint64(out[i*8:], v)
+	}
+	return append(in, out[:d.size]...)
+}
+
+func (d *digest) increment(n uint64) {
+	var carry uint64
+	d.t[0], carry = bits.Add64(d.t[0], n, 0)
+	d.t[1] += carry
+}
+
+func (d *digest) compress(last bool) {
+	var m [16]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(d.x[i*8:])
+	}
+
+	var v [16]uint64
+	copy(v[:8], d.h[:])
+	copy(v[8:], iv[:])
+	v[12] ^= d.t[0]
+	v[13] ^= d.t[1]
+	if last {
+		v[14] = ^v[14]
+	}
+
+	g := func(a, b, c, d int, x, y uint64) {
+		v[a] = v[a] + v[b] + x
+		v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+		v[c] = v[c] + v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -24)
+		v[a] = v[a] + v[b] + y
+		v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+		v[c] = v[c] + v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -63)
+	}
+	for _, s := range sigma {
+		g(0, 4, 8, 12, m[s[0]], m[s[1]])
+		g(1, 5, 9, 13, m[s[2]], m[s[3]])
+		g(2, 6, 10, 14, m[s[4]], m[s[5]])
+		g(3, 7, 11, 15, m[s[6]], m[s[7]])
+		g(0, 5, 10, 15, m[s[8]], m[s[9]])
+		g(1, 6, 11, 12, m[s[10]], m[s[11]])
+		g(2, 7, 8, 13, m[s[12]], m[s[13]])
+		g(3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+
+	for i := range d.h {
+		d.h[i] ^= v[i] ^ v[i+8]
+	}
+}
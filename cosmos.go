@@ -0,0 +1,93 @@
+package addressutil
+
+import (
+	"strings"
+
+	"github.com/suyhuai/addressutil/hash160"
+	"github.com/suyhuai/addressutil/util"
+	"github.com/suyhuai/addressutil/util/cosmosutil"
+)
+
+// cosmosChains maps the Cosmos SDK chains NewAddress and CheckAddress
+// handle to the bech32 prefix of their account addresses.
+var cosmosChains = map[string]string{
+	"ATOM": "cosmos",
+	"OSMO": "osmo",
+	"LUNA": "terra",
+	"KAVA": "kava",
+	"AKT":  "akash",
+	"JUNO": "juno",
+	"SCRT": "secret",
+}
+
+// RegisterCosmosChain makes NewAddress and CheckAddress handle chain as a
+// Cosmos SDK chain whose account addresses use the bech32 prefix hrp.  It
+// returns ErrDuplicateChain when chain is already handled in any other way.
+func RegisterCosmosChain(chain, hrp string) error {
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+
+	chain = strings.ToUpper(chain)
+	if err := util.ReserveChain(chain); err != nil {
+		return err
+	}
+	cosmosChains[chain] = hrp
+	return nil
+}
+
+func lookupCosmosChain(chain string) (string, bool) {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+
+	hrp, ok := cosmosChains[chain]
+	return hrp, ok
+}
+
+// CosmosAddress is the bech32 address of a secp256k1 account key on a Cosmos
+// SDK chain: the hash160 of its compressed public key.
+type CosmosAddress struct {
+	hrp    string
+	addr   string
+	pubKey []byte
+}
+
+// NewCosmosAddress returns the address of pubKey under the human-readable
+// part hrp, see cosmosutil.HRP for validator and consensus prefixes.
+func NewCosmosAddress(pubKey interface{}, hrp string) (*CosmosAddress, error) {
+	pub, _, err := parsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized := pub.SerializeCompressed()
+	addr, err := cosmosutil.Encode(hrp, hash160.Hash160(serialized))
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosAddress{
+		hrp:    hrp,
+		addr:   addr,
+		pubKey: serialized,
+	}, nil
+}
+
+func (a *CosmosAddress) String() string {
+	return a.addr
+}
+
+func (a *CosmosAddress) Url() string {
+	return a.String()
+}
+
+// HRP returns the human-readable part of the address.
+func (a *CosmosAddress) HRP() string {
+	return a.hrp
+}
+
+// CheckCosmosAddress reports whether address is a valid bech32 address with
+// the human-readable part hrp.
+func CheckCosmosAddress(address, hrp string) bool {
+	decodedHRP, _, err := cosmosutil.Decode(address)
+	return err == nil && decodedHRP == hrp
+}
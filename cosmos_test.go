@@ -0,0 +1,109 @@
+package addressutil
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/suyhuai/addressutil/util/cosmosutil"
+)
+
+func TestCosmosAddress(t *testing.T) {
+	pub, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	tests := []struct {
+		chain string
+		addr  string
+	}{
+		{"ATOM", "cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60c"},
+		{"OSMO", "osmo1w508d6qejxtdg4y5r3zarvary0c5xw7kjxy2e2"},
+		{"LUNA", "terra1w508d6qejxtdg4y5r3zarvary0c5xw7kued6dc"},
+	}
+
+	for _, test := range tests {
+		if a, err := NewAddress(test.chain, pub, MainNet); err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if a.String() != test.addr {
+			t.Log("Address mismatch", a, test.addr)
+			t.Fail()
+		}
+	}
+
+	for typ, addr := range map[cosmosutil.AddressType]string{
+		cosmosutil.Validator: "cosmosvaloper1w508d6qejxtdg4y5r3zarvary0c5xw7klfr0rt",
+		cosmosutil.Consensus: "cosmosvalcons1w508d6qejxtdg4y5r3zarvary0c5xw7kt6sn02",
+	} {
+		if a, err := NewCosmosAddress(pub, cosmosutil.HRP("cosmos", typ)); err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if a.String() != addr {
+			t.Log("Address mismatch", a, addr)
+			t.Fail()
+		}
+	}
+}
+
+func TestCheckCosmosAddress(t *testing.T) {
+	validAddresses := []string{
+		"cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60c",
+		"cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qarc0sxaggsw",
+	}
+	invalidAddresses := []string{
+		"cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60d",
+		"osmo1w508d6qejxtdg4y5r3zarvary0c5xw7kjxy2e2",
+		"cosmosvaloper1w508d6qejxtdg4y5r3zarvary0c5xw7klfr0rt",
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+	}
+
+	for _, address := range validAddresses {
+		if !CheckAddress(address, "ATOM", MainNet) {
+			t.Log("expected valid", address)
+			t.Fail()
+		}
+	}
+
+	for _, address := range invalidAddresses {
+		if CheckAddress(address, "ATOM", MainNet) {
+			t.Log("expected invalid", address)
+			t.Fail()
+		}
+	}
+}
+
+func TestCosmosConvertHRP(t *testing.T) {
+	osmo, err := cosmosutil.ConvertHRP("cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60c", "osmo")
+	if err != nil || osmo != "osmo1w508d6qejxtdg4y5r3zarvary0c5xw7kjxy2e2" {
+		t.Log("convert HRP fail: ", osmo, err)
+		t.Fail()
+	}
+
+	if err := RegisterCosmosChain("ATOM", "cosmos"); err != ErrDuplicateChain {
+		t.Log("unexpected error", err)
+		t.Fail()
+	}
+
+	// Chain names are case-insensitive, whatever case they are registered in.
+	if err := RegisterCosmosChain("strd", "stride"); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterCosmosChain("STRD", "stride"); err != ErrDuplicateChain {
+		t.Log("unexpected error", err)
+		t.Fail()
+	}
+	for _, chain := range []string{"STRD", "strd", "Strd"} {
+		a, err := NewAddress(chain, "02394bc53633366a2ab9b5d697a94c8c0121cc5e3f0d554a63167edb318ceae8bc", MainNet)
+		if err != nil || !strings.HasPrefix(a.String(), "stride1") {
+			t.Log("register chain fail: ", chain, a, err)
+			t.Fail()
+			continue
+		}
+		if !CheckAddress(a.String(), chain, MainNet) || CheckAddress("garbage", chain, MainNet) {
+			t.Log("check address fail: ", chain)
+			t.Fail()
+		}
+	}
+	if CheckAddress("garbage", "btc", MainNet) {
+		t.Log("lower-case built-in chain not checked")
+		t.Fail()
+	}
+}
@@ -0,0 +1,70 @@
+package addressutil
+
+import (
+	"github.com/suyhuai/addressutil/util"
+	"github.com/suyhuai/addressutil/util/dashutil/chaincfg"
+)
+
+type DASHAddress struct {
+	Address
+
+	params *util.Params
+	addr   string
+	pubKey []byte
+}
+
+func NewDASHAddress(pubKey interface{}, net Network) (*DASHAddress, error) {
+	_, serialized, err := parsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := dashNetParams(net)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DASHAddress{
+		params: params,
+		pubKey: serialized,
+	}, nil
+}
+
+func (a *DASHAddress) String() string {
+	if a.addr != "" {
+		return a.addr
+	}
+
+	ba := &BTCAddress{
+		params: a.params,
+		pubKey: a.pubKey,
+	}
+
+	a.addr = ba.String()
+
+	return a.addr
+}
+
+func (a *DASHAddress) Url() string {
+	return a.String()
+}
+
+func CheckDASHAddress(address string, net Network) bool {
+	params, err := dashNetParams(net)
+	if err != nil {
+		return false
+	}
+	return checkUTXOAddress(address, params)
+}
+
+// dashNetParams returns the chain parameters of the Dash network net.
+func dashNetParams(net Network) (*util.Params, error) {
+	switch net {
+	case MainNet:
+		return &chaincfg.MainNetParams, nil
+	case TestNet:
+		return &chaincfg.TestNet3Params, nil
+	default:
+		return nil, ErrUnsupportedNetwork
+	}
+}
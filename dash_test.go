@@ -0,0 +1,62 @@
+package addressutil
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestDASHAddress(t *testing.T) {
+	pub, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	tests := []struct {
+		net  Network
+		addr string
+	}{
+		{MainNet, "XmN7PQYWKn5MJFna5fRYgP6mxT2F7xpekE"},
+		{TestNet, "yWziQMcwmKjRdzi7eWjwiQX8EjWcd6dSg6"},
+	}
+
+	for _, test := range tests {
+		if a, err := NewDASHAddress(pub, test.net); err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if a.String() != test.addr {
+			t.Log("Address mismatch", a, test.addr)
+			t.Fail()
+		}
+	}
+
+	if _, err := NewDASHAddress(pub, RegTest); err != ErrUnsupportedNetwork {
+		t.Log("expected ErrUnsupportedNetwork, got", err)
+		t.Fail()
+	}
+}
+
+func TestCheckDASHAddress(t *testing.T) {
+	validAddresses := []string{
+		"XmN7PQYWKn5MJFna5fRYgP6mxT2F7xpekE",
+		"7d5vJtfDixGnEFRNcVSRarmaCBZeScHACn",
+	}
+	invalidAddresses := []string{
+		"XmN7PQYWKn5MJFna5fRYgP6mxT2F7xpekF",
+		"yWziQMcwmKjRdzi7eWjwiQX8EjWcd6dSg6",
+		"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+	}
+
+	for _, address := range validAddresses {
+		if !CheckDASHAddress(address, MainNet) {
+			t.Log("expected valid", address)
+			t.Fail()
+		}
+	}
+
+	for _, address := range invalidAddresses {
+		if CheckDASHAddress(address, MainNet) {
+			t.Log("expected invalid", address)
+			t.Fail()
+		}
+	}
+
+	if !CheckDASHAddress("8q6jGDZ5rVfQgYqdgkSP3Eaw5hLUXD8Nyi", TestNet) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,70 @@
+package addressutil
+
+import (
+	"github.com/suyhuai/addressutil/util"
+	"github.com/suyhuai/addressutil/util/dogeutil/chaincfg"
+)
+
+type DOGEAddress struct {
+	Address
+
+	params *util.Params
+	addr   string
+	pubKey []byte
+}
+
+func NewDOGEAddress(pubKey interface{}, net Network) (*DOGEAddress, error) {
+	_, serialized, err := parsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := dogeNetParams(net)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DOGEAddress{
+		params: params,
+		pubKey: serialized,
+	}, nil
+}
+
+func (a *DOGEAddress) String() string {
+	if a.addr != "" {
+		return a.addr
+	}
+
+	ba := &BTCAddress{
+		params: a.params,
+		pubKey: a.pubKey,
+	}
+
+	a.addr = ba.String()
+
+	return a.addr
+}
+
+func (a *DOGEAddress) Url() string {
+	return a.String()
+}
+
+func CheckDOGEAddress(address string, net Network) bool {
+	params, err := dogeNetParams(net)
+	if err != nil {
+		return false
+	}
+	return checkUTXOAddress(address, params)
+}
+
+// dogeNetParams returns the chain parameters of the Dogecoin network net.
+func dogeNetParams(net Network) (*util.Params, error) {
+	switch net {
+	case MainNet:
+		return &chaincfg.MainNetParams, nil
+	case TestNet:
+		return &chaincfg.TestNet3Params, nil
+	default:
+		return nil, ErrUnsupportedNetwork
+	}
+}
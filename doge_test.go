@@ -0,0 +1,62 @@
+package addressutil
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestDOGEAddress(t *testing.T) {
+	pub, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	tests := []struct {
+		net  Network
+		addr string
+	}{
+		{MainNet, "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE"},
+		{TestNet, "nesRpRaAbTDmZHwmzBkLd2AtF7Z9L9z5S2"},
+	}
+
+	for _, test := range tests {
+		if a, err := NewDOGEAddress(pub, test.net); err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if a.String() != test.addr {
+			t.Log("Address mismatch", a, test.addr)
+			t.Fail()
+		}
+	}
+
+	if _, err := NewDOGEAddress(pub, RegTest); err != ErrUnsupportedNetwork {
+		t.Log("expected ErrUnsupportedNetwork, got", err)
+		t.Fail()
+	}
+}
+
+func TestCheckDOGEAddress(t *testing.T) {
+	validAddresses := []string{
+		"DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE",
+		"A37YDYSwz3438rFtm1SLVcQHyD7JeueC9H",
+	}
+	invalidAddresses := []string{
+		"DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLF",
+		"nesRpRaAbTDmZHwmzBkLd2AtF7Z9L9z5S2",
+		"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+	}
+
+	for _, address := range validAddresses {
+		if !CheckDOGEAddress(address, MainNet) {
+			t.Log("expected valid", address)
+			t.Fail()
+		}
+	}
+
+	for _, address := range invalidAddresses {
+		if CheckDOGEAddress(address, MainNet) {
+			t.Log("expected invalid", address)
+			t.Fail()
+		}
+	}
+
+	if !CheckDOGEAddress("2N3vVYSK5XRgVSGWy21PnsRmBUywSQNdCsf", TestNet) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,94 @@
+package addressutil
+
+import (
+	"strings"
+
+	"github.com/suyhuai/addressutil/blake2b"
+	"github.com/suyhuai/addressutil/util"
+	"github.com/suyhuai/addressutil/util/ss58util"
+)
+
+// substrateChains maps the Substrate chains NewAddress and CheckAddress
+// handle to their SS58 network prefix.
+var substrateChains = map[string]uint16{
+	"DOT": 0,
+	"KSM": 2,
+}
+
+// RegisterSubstrateChain makes NewAddress and CheckAddress handle chain as a
+// Substrate chain whose addresses use the SS58 network prefix.  It returns
+// ErrDuplicateChain when chain is already handled in any other way.
+func RegisterSubstrateChain(chain string, prefix uint16) error {
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+
+	chain = strings.ToUpper(chain)
+	if err := util.ReserveChain(chain); err != nil {
+		return err
+	}
+	substrateChains[chain] = prefix
+	return nil
+}
+
+func lookupSubstrateChain(chain string) (uint16, bool) {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+
+	prefix, ok := substrateChains[chain]
+	return prefix, ok
+}
+
+// SubstrateAddress is the SS58 address of a Substrate account.
+type SubstrateAddress struct {
+	prefix    uint16
+	addr      string
+	accountID []byte
+}
+
+// NewSubstrateAddress returns the address of pubKey on the network prefix.
+// sr25519 and ed25519 keys are their own account ID and may be given as 32
+// raw bytes; secp256k1 keys are hashed to their account ID with blake2b-256
+// of the compressed key.
+func NewSubstrateAddress(pubKey interface{}, prefix uint16) (*SubstrateAddress, error) {
+	accountID, err := parseEd25519PubKey(pubKey)
+	if err == ErrKeyType {
+		pub, _, err := parsePubKey(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		accountID = blake2b.Sum256(pub.SerializeCompressed())
+	} else if err != nil {
+		return nil, err
+	}
+
+	addr, err := ss58util.Encode(accountID, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubstrateAddress{
+		prefix:    prefix,
+		addr:      addr,
+		accountID: accountID,
+	}, nil
+}
+
+func (a *SubstrateAddress) String() string {
+	return a.addr
+}
+
+func (a *SubstrateAddress) Url() string {
+	return a.String()
+}
+
+// Prefix returns the SS58 network prefix of the address.
+func (a *SubstrateAddress) Prefix() uint16 {
+	return a.prefix
+}
+
+// CheckSubstrateAddress reports whether address is a valid SS58 address of
+// the network prefix.
+func CheckSubstrateAddress(address string, prefix uint16) bool {
+	_, decoded, err := ss58util.Decode(address)
+	return err == nil && decoded == prefix
+}
@@ -0,0 +1,77 @@
+package addressutil
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/suyhuai/addressutil/ecc"
+	"github.com/suyhuai/addressutil/util/ss58util"
+)
+
+func TestSubstrateAddress(t *testing.T) {
+	alice, _ := hex.DecodeString("d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d")
+	tests := []struct {
+		prefix uint16
+		addr   string
+	}{
+		{0, "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"},
+		{2, "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F"},
+		{42, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"},
+		{64, "cEaNSpz4PxFcZ7nT1VEKrKewH67rfx6MfcM6yKojyyPz7qaqp"},
+		{255, "yGHXkYLYqxijLKKfd9Q2CB9shRVu8rPNBS53wvwGTutYg4zTg"},
+		{1284, "VdvKmYJfD4VXA9fzz1SbmCo2eYHSzUFbaDCZSuaNKJAe8YNg6"},
+		{16383, "yNa8JpqfFB3q8A29rCwSgxvdU94ufJw2yKKxDgznS5m1PoFvn"},
+	}
+
+	for _, test := range tests {
+		a, err := NewSubstrateAddress(alice, test.prefix)
+		if err != nil {
+			t.Log(err)
+			t.Fail()
+			continue
+		}
+		if a.String() != test.addr {
+			t.Log("Address mismatch", a, test.addr)
+			t.Fail()
+		}
+		if !CheckSubstrateAddress(test.addr, test.prefix) {
+			t.Log("Address check failed", test.addr)
+			t.Fail()
+		}
+		if addr, err := ss58util.ConvertPrefix(tests[0].addr, test.prefix); err != nil || addr != test.addr {
+			t.Log("Prefix conversion failed", addr, err)
+			t.Fail()
+		}
+	}
+
+	if a, err := NewAddress("DOT", ecc.Ed25519PublicKey(alice), MainNet); err != nil || a.String() != tests[0].addr {
+		t.Log("DOT address mismatch", a, err)
+		t.Fail()
+	}
+	if !CheckAddress(tests[1].addr, "KSM", MainNet) || CheckAddress(tests[0].addr, "KSM", MainNet) {
+		t.Log("KSM address check failed")
+		t.Fail()
+	}
+
+	secp, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	if a, err := NewSubstrateAddress(secp, 42); err != nil || a.String() != "5D14rgDrpYMeQDnqqnrVRDySA8AYLrwyKC13scBZgmhSh9ur" {
+		t.Log("secp256k1 address mismatch", a, err)
+		t.Fail()
+	}
+
+	if _, err := NewSubstrateAddress(alice, 46); err != ss58util.ErrPrefix {
+		t.Log("Reserved prefix accepted", err)
+		t.Fail()
+	}
+	for _, addr := range []string{
+		"",
+		"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQZ",
+		"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKut",
+		"0GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+	} {
+		if CheckSubstrateAddress(addr, 42) {
+			t.Log("Invalid address accepted", addr)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package ecc
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"math/big"
+)
+
+// KeyType identifies the curve of a key.
+type KeyType int
+
+const (
+	Secp256k1 KeyType = iota + 1
+	Ed25519
+)
+
+func (t KeyType) String() string {
+	switch t {
+	case Secp256k1:
+		return "secp256k1"
+	case Ed25519:
+		return "ed25519"
+	default:
+		return "unknown"
+	}
+}
+
+// PubKey is a public key of any supported curve.
+type PubKey interface {
+	// KeyType returns the curve of the key.
+	KeyType() KeyType
+
+	// Bytes returns the canonical serialization of the key: 33 bytes
+	// compressed for secp256k1, the 32 byte point for ed25519.
+	Bytes() []byte
+}
+
+// PrivKey is a private key of any supported curve.
+type PrivKey interface {
+	// KeyType returns the curve of the key.
+	KeyType() KeyType
+
+	// Public returns the public key of the private key.
+	Public() PubKey
+}
+
+var ErrEd25519KeyFormat = errors.New("invalid ed25519 key")
+
+// KeyType returns Secp256k1.
+func (p *PublicKey) KeyType() KeyType {
+	return Secp256k1
+}
+
+// Bytes returns the key serialized in the 33-byte compressed format.
+func (p *PublicKey) Bytes() []byte {
+	return p.SerializeCompressed()
+}
+
+// KeyType returns Secp256k1.
+func (p *PrivateKey) KeyType() KeyType {
+	return Secp256k1
+}
+
+// Public returns the PublicKey corresponding to this private key.
+func (p *PrivateKey) Public() PubKey {
+	return p.PubKey()
+}
+
+// Ed25519PublicKey is an ed25519 public key.
+type Ed25519PublicKey ed25519.PublicKey
+
+// ParseEd25519PubKey parses a 32 byte ed25519 public key.
+func ParseEd25519PubKey(b []byte) (Ed25519PublicKey, error) {
+	if len(b) != ed25519.PublicKeySize {
+		return nil, ErrEd25519KeyFormat
+	}
+	return append(Ed25519PublicKey(nil), b...), nil
+}
+
+// KeyType returns Ed25519.
+func (p Ed25519PublicKey) KeyType() KeyType {
+	return Ed25519
+}
+
+// Bytes returns the 32 byte key.
+func (p Ed25519PublicKey) Bytes() []byte {
+	return []byte(p)
+}
+
+// Ed25519PrivateKey is an ed25519 private key in the 64 byte seed and public
+// key form of crypto/ed25519.
+type Ed25519PrivateKey ed25519.PrivateKey
+
+// NewEd25519PrivateKey returns the ed25519 private key of the 32 byte seed.
+func NewEd25519PrivateKey(seed []byte) (Ed25519PrivateKey, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, ErrEd25519KeyFormat
+	}
+	return Ed25519PrivateKey(ed25519.NewKeyFromSeed(seed)), nil
+}
+
+// KeyType returns Ed25519.
+func (p Ed25519PrivateKey) KeyType() KeyType {
+	return Ed25519
+}
+
+// Public returns the Ed25519PublicKey corresponding to this private key.
+func (p Ed25519PrivateKey) Public() PubKey {
+	return p.PubKey()
+}
+
+// PubKey returns the Ed25519PublicKey corresponding to this private key.
+func (p Ed25519PrivateKey) PubKey() Ed25519PublicKey {
+	return Ed25519PublicKey(ed25519.PrivateKey(p).Public().(ed25519.PublicKey))
+}
+
+// Seed returns the 32 byte seed of the key.
+func (p Ed25519PrivateKey) Seed() []byte {
+	return ed25519.PrivateKey(p).Seed()
+}
+
+// Sign signs message with the key.
+func (p Ed25519PrivateKey) Sign(message []byte) []byte {
+	return ed25519.Sign(ed25519.PrivateKey(p), message)
+}
+
+var (
+	// ed25519P is the field prime 2^255 - 19 of curve25519.
+	ed25519P = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+
+	// ed25519D is the curve constant d = -121665/121666 of edwards25519.
+	ed25519D, _ = new(big.Int).SetString("37095705934669439343138083508754565189542113879843219016388785533085940283555", 10)
+)
+
+// IsOnEd25519Curve reports whether b is the compressed encoding of a point on
+// edwards25519, that is whether some x satisfies the curve equation for the
+// y coordinate in b.  Like the decompression of curve25519-dalek, which
+// Solana uses, y is reduced modulo p and the sign bit is not checked.
+func IsOnEd25519Curve(b []byte) bool {
+	if len(b) != ed25519.PublicKeySize {
+		return false
+	}
+
+	le := make([]byte, len(b))
+	for i := range b {
+		le[len(b)-1-i] = b[i]
+	}
+	le[0] &= 0x7f
+	y := new(big.Int).SetBytes(le)
+	y.Mod(y, ed25519P)
+
+	// x^2 = (y^2 - 1) / (d y^2 + 1), which has a solution iff the ratio is
+	// zero or a quadratic residue.
+	y2 := new(big.Int).Mul(y, y)
+	u := new(big.Int).Sub(y2, big.NewInt(1))
+	u.Mod(u, ed25519P)
+	v := new(big.Int).Mul(ed25519D, y2)
+	v.Add(v, big.NewInt(1))
+	v.Mod(v, ed25519P)
+
+	x2 := new(big.Int).ModInverse(v, ed25519P)
+	x2.Mul(x2, u)
+	x2.Mod(x2, ed25519P)
+	if x2.Sign() == 0 {
+		return true
+	}
+	exp := new(big.Int).Rsh(new(big.Int).Sub(ed25519P, big.NewInt(1)), 1)
+	return new(big.Int).Exp(x2, exp, ed25519P).Cmp(big.NewInt(1)) == 0
+}
@@ -0,0 +1,94 @@
+package ecc
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// HardenedKeyStart is the index of the first hardened child key.
+const HardenedKeyStart = 0x80000000
+
+// ed25519SeedKey is the HMAC key SLIP-10 derives ed25519 master keys with.
+var ed25519SeedKey = []byte("ed25519 seed")
+
+var (
+	ErrNonHardenedChild = errors.New("ed25519 keys only have hardened children")
+	ErrInvalidSeedLen   = errors.New("seed must be between 16 and 64 bytes")
+	ErrInvalidPath      = errors.New("invalid derivation path")
+)
+
+// ExtendedEd25519Key is a SLIP-10 extended ed25519 private key.
+type ExtendedEd25519Key struct {
+	key       [32]byte
+	chainCode [32]byte
+}
+
+// NewEd25519MasterKey returns the SLIP-10 ed25519 master key of seed.
+func NewEd25519MasterKey(seed []byte) (*ExtendedEd25519Key, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, ErrInvalidSeedLen
+	}
+	return newExtendedEd25519Key(ed25519SeedKey, seed), nil
+}
+
+func newExtendedEd25519Key(key, data []byte) *ExtendedEd25519Key {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	k := &ExtendedEd25519Key{}
+	copy(k.key[:], sum[:32])
+	copy(k.chainCode[:], sum[32:])
+	return k
+}
+
+// Child returns the child key at index, which must be hardened.
+func (k *ExtendedEd25519Key) Child(index uint32) (*ExtendedEd25519Key, error) {
+	if index < HardenedKeyStart {
+		return nil, ErrNonHardenedChild
+	}
+	data := make([]byte, 1+32+4)
+	copy(data[1:], k.key[:])
+	binary.BigEndian.PutUint32(data[33:], index)
+	return newExtendedEd25519Key(k.chainCode[:], data), nil
+}
+
+// DerivePath returns the descendant of k at path, such as "m/44'/501'/0'".
+// Every element of path must be hardened, marked by ' or H.
+func (k *ExtendedEd25519Key) DerivePath(path string) (*ExtendedEd25519Key, error) {
+	elems := strings.Split(path, "/")
+	if elems[0] != "m" {
+		return nil, ErrInvalidPath
+	}
+	for _, elem := range elems[1:] {
+		hardened := strings.HasSuffix(elem, "'") || strings.HasSuffix(elem, "H")
+		if !hardened {
+			return nil, ErrNonHardenedChild
+		}
+		index, err := strconv.ParseUint(elem[:len(elem)-1], 10, 31)
+		if err != nil {
+			return nil, ErrInvalidPath
+		}
+
+		k, err = k.Child(uint32(index) + HardenedKeyStart)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+// PrivateKey returns the ed25519 private key of k.
+func (k *ExtendedEd25519Key) PrivateKey() Ed25519PrivateKey {
+	priv, _ := NewEd25519PrivateKey(k.key[:])
+	return priv
+}
+
+// ChainCode returns the chain code of k.
+func (k *ExtendedEd25519Key) ChainCode() []byte {
+	return append([]byte(nil), k.chainCode[:]...)
+}
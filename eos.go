@@ -0,0 +1,62 @@
+package addressutil
+
+import (
+	"github.com/suyhuai/addressutil/ecc"
+	"github.com/suyhuai/addressutil/util/eosutil"
+)
+
+// EOSAddress is the public key string an EOS account permission is bound to.
+// EOS accounts themselves are names chosen at creation time and can not be
+// derived from a key, see eosutil.CheckEOSAccount.  CheckAddress and
+// ParseAddress accept both account names and public key strings for "EOS".
+type EOSAddress struct {
+	addr   string
+	pubKey *ecc.PublicKey
+}
+
+func NewEOSAddress(pubKey interface{}) (*EOSAddress, error) {
+	pub, _, err := parsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EOSAddress{
+		addr:   eosutil.PublicKeyToString(pub),
+		pubKey: pub,
+	}, nil
+}
+
+// ParseEOSAddress parses a public key string in the legacy "EOS…" or the
+// "PUB_K1_…" form.
+func ParseEOSAddress(address string) (*EOSAddress, error) {
+	pub, err := eosutil.ParsePublicKey(address)
+	if err != nil {
+		return nil, ErrAddressFormat
+	}
+
+	return &EOSAddress{
+		addr:   address,
+		pubKey: pub,
+	}, nil
+}
+
+// CheckEOSAddress reports whether address is a valid EOS public key string.
+// Funds are sent to account names, which CheckAddress checks, not to keys.
+func CheckEOSAddress(address string) bool {
+	_, err := ParseEOSAddress(address)
+	return err == nil
+}
+
+// String returns the legacy "EOS…" form of the public key.
+func (a *EOSAddress) String() string {
+	return a.addr
+}
+
+func (a *EOSAddress) Url() string {
+	return a.String()
+}
+
+// K1String returns the "PUB_K1_…" form of the public key.
+func (a *EOSAddress) K1String() string {
+	return eosutil.PublicKeyToK1String(a.pubKey)
+}
@@ -0,0 +1,159 @@
+package addressutil
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/suyhuai/addressutil/base58"
+	"github.com/suyhuai/addressutil/ecc"
+	"github.com/suyhuai/addressutil/util/eosutil"
+)
+
+func TestEOSAddress(t *testing.T) {
+	wif := "5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD3"
+	priv, _, err := base58.CheckDecode(wif)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey, _ := ecc.PrivKeyFromBytes(ecc.S256(), priv)
+
+	a, err := NewEOSAddress(privKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.String() != "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV" {
+		t.Log("Address mismatch", a)
+		t.Fail()
+	}
+	if a.K1String() != "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63" {
+		t.Log("Address mismatch", a.K1String())
+		t.Fail()
+	}
+
+	for _, s := range []string{a.String(), a.K1String()} {
+		pub, err := eosutil.ParsePublicKey(s)
+		if err != nil || !pub.IsEqual(privKey.PubKey()) {
+			t.Log("parse public key fail: ", s, err)
+			t.Fail()
+		}
+	}
+	if _, err := eosutil.ParsePublicKey("EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CW"); err == nil {
+		t.Fail()
+	}
+
+	// Key strings round trip through the key API, but funds are sent to
+	// accounts, so CheckAddress keeps rejecting them.
+	for _, s := range []string{a.String(), a.K1String()} {
+		if !CheckEOSAddress(s) {
+			t.Log("check key fail: ", s)
+			t.Fail()
+		}
+		if parsed, err := ParseEOSAddress(s); err != nil || parsed.String() != s {
+			t.Log("parse key fail: ", s, err)
+			t.Fail()
+		}
+		if CheckAddress(s, "EOS", MainNet) {
+			t.Log("key accepted as account: ", s)
+			t.Fail()
+		}
+	}
+	if CheckEOSAddress("EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CW") {
+		t.Fail()
+	}
+
+	pvt := eosutil.PrivateKeyToString(privKey)
+	if pvt != "PVT_K1_2bfGi9rYsXQSXXTvJbDAPhHLQUojjaNLomdm3cEJ1XTzMqUt3V" {
+		t.Log("Private key mismatch", pvt)
+		t.Fail()
+	}
+	if parsed, err := eosutil.ParsePrivateKey(pvt); err != nil || parsed.D.Cmp(privKey.D) != 0 {
+		t.Log("parse private key fail: ", err)
+		t.Fail()
+	}
+}
+
+func TestEOSSignature(t *testing.T) {
+	privKey, _ := ecc.PrivKeyFromBytes(ecc.S256(), []byte("0123456789abcdef0123456789abcdef"))
+	hash := make([]byte, 32)
+
+	compact, err := ecc.SignCompact(ecc.S256(), privKey, hash, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := &ecc.Signature{
+		R: new(big.Int).SetBytes(compact[1:33]),
+		S: new(big.Int).SetBytes(compact[33:]),
+	}
+
+	s := eosutil.SignatureToString(sig, compact[0]-27-4)
+	parsed, recoveryID, err := eosutil.ParseSignature(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.IsEqual(sig) || recoveryID != compact[0]-27-4 {
+		t.Log("Signature mismatch", s)
+		t.Fail()
+	}
+	if !parsed.Verify(hash, privKey.PubKey()) {
+		t.Fail()
+	}
+}
+
+func TestCheckEOSAccount(t *testing.T) {
+	validAccounts := []string{
+		"eosio",
+		"eosio.token",
+		"a",
+		"123451234512",
+		"1234512345123",
+		"zzzzzzzzzzzzj",
+	}
+	invalidAccounts := []string{
+		"",
+		"eosio.",
+		"EOSIO",
+		"eosio6",
+		"zzzzzzzzzzzzk",
+		"12345123451234",
+	}
+
+	for _, account := range validAccounts {
+		if !CheckAddress(account, "EOS", MainNet) {
+			t.Log("check account fail: ", account)
+			t.Fail()
+		}
+	}
+	for _, account := range invalidAccounts {
+		if CheckAddress(account, "EOS", MainNet) {
+			t.Log("invalid account accepted: ", account)
+			t.Fail()
+		}
+	}
+}
+
+func TestEOSName(t *testing.T) {
+	names := map[string]uint64{
+		"eosio":         6138663577826885632,
+		"eosio.token":   6138663591592764928,
+		"transfer":      14829575313431724032,
+		"zzzzzzzzzzzzj": 0xffffffffffffffff,
+	}
+
+	for name, value := range names {
+		if n, err := eosutil.NameToUint64(name); err != nil || n != value {
+			t.Log("Name mismatch", name, n, err)
+			t.Fail()
+		}
+		if s := eosutil.Uint64ToName(value); s != name {
+			t.Log("Name mismatch", value, s)
+			t.Fail()
+		}
+	}
+
+	if eosutil.NameSuffix("bob.eos") != "eos" || eosutil.NameSuffix("eosio") != "eosio" {
+		t.Fail()
+	}
+	if !eosutil.IsPremiumName("eos") || eosutil.IsPremiumName("bob.eos") || eosutil.IsPremiumName("abcdefghijkl") {
+		t.Fail()
+	}
+}
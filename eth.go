@@ -1,23 +1,109 @@
 package addressutil
 
 import (
-	"encoding/hex"
+	"strings"
+
+	"github.com/suyhuai/addressutil/util"
 	"github.com/suyhuai/addressutil/util/ethutil"
 	"golang.org/x/crypto/sha3"
-	"strings"
 )
 
+// EVMChain describes how the addresses of an EVM compatible chain are
+// checksummed.
+type EVMChain struct {
+	ChainID     uint64
+	TestChainID uint64
+
+	// EIP1191 reports whether the chain ID is part of the address checksum.
+	// CheckAddress enforces the checksum of mixed case addresses on such
+	// chains.  Chains that keep the plain EIP55 checksum leave it false.
+	EIP1191 bool
+}
+
+// checksumChainID returns the chain ID to mix into the address checksum, zero
+// when the chain uses plain EIP55.  EIP1191 chains only know the chain IDs of
+// MainNet and TestNet.
+func (c EVMChain) checksumChainID(net Network) (uint64, error) {
+	if !c.EIP1191 {
+		return 0, nil
+	}
+	switch net {
+	case MainNet:
+		return c.ChainID, nil
+	case TestNet:
+		return c.TestChainID, nil
+	default:
+		return 0, ErrUnsupportedNetwork
+	}
+}
+
+// checkAddress reports whether address is a valid address of the chain on
+// net.  Mixed case input must carry a valid checksum on EIP1191 chains, and on
+// the other chains when checksum is set.
+func (c EVMChain) checkAddress(address string, net Network, checksum bool) bool {
+	chainID, err := c.checksumChainID(net)
+	if err != nil {
+		return false
+	}
+	if chainID == 0 && !checksum {
+		return CheckETHAddress(address)
+	}
+	return CheckETHChecksum(address, chainID)
+}
+
+var evmChains = map[string]EVMChain{
+	"ETH": {ChainID: 1, TestChainID: 11155111},
+	"ETC": {ChainID: 61, TestChainID: 63},
+	"RSK": {ChainID: 30, TestChainID: 31, EIP1191: true},
+}
+
+// RegisterEVMChain makes NewAddress and CheckAddress handle chain as an EVM
+// compatible chain using the checksum scheme described by c.  It returns
+// ErrDuplicateChain when chain is already handled in any other way.
+func RegisterEVMChain(chain string, c EVMChain) error {
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+
+	chain = strings.ToUpper(chain)
+	if err := util.ReserveChain(chain); err != nil {
+		return err
+	}
+	evmChains[chain] = c
+	return nil
+}
+
+func lookupEVMChain(chain string) (EVMChain, bool) {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+
+	c, ok := evmChains[chain]
+	return c, ok
+}
+
 type ETHAddress struct {
 	Address
 
-	addr   string
-	pubKey []byte
+	addr    string
+	pubKey  []byte
+	chainID uint64
 }
 
-func NewETHAddress(pubKey []byte) (*ETHAddress, error) {
-	return &ETHAddress{
-		pubKey: pubKey,
-	}, nil
+// NewETHAddress returns the address of pubKey.  When a non-zero chainID is
+// given the address is checksummed according to EIP1191 instead of EIP55.
+func NewETHAddress(pubKey interface{}, chainID ...uint64) (*ETHAddress, error) {
+	pub, _, err := parsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	address := &ETHAddress{
+		pubKey: pub.SerializeUncompressed(),
+	}
+	if len(chainID) > 0 {
+		address.chainID = chainID[0]
+	}
+
+	return address, nil
 }
 
 func (a *ETHAddress) String() string {
@@ -28,33 +114,55 @@ func (a *ETHAddress) String() string {
 	hash := sha3.NewLegacyKeccak256()
 	hash.Write(a.pubKey[1:])
 	b := hash.Sum(nil)
-	addrBytes := []byte(hex.EncodeToString(b[12:]))
 
-	hash.Reset()
-	hash.Write(addrBytes)
-	b = hash.Sum(nil)
-	check := hex.EncodeToString(b)
-
-	for i := 0; i < len(addrBytes); i++ {
-		if check[i] >= '8' && addrBytes[i] >= 'a' {
-			addrBytes[i] -= 0x20
-		}
-	}
-
-	return "0x" + string(addrBytes)
+	a.addr = ethutil.BytesToAddress(b[12:]).ChecksumHex(a.chainID)
+	return a.addr
 }
 
 func (a *ETHAddress) Url() string {
 	return a.String()
 }
 
-func CheckETHAddress(address string) bool {
+// CheckETHAddress reports whether address is a well formed hex address.  Any
+// casing is accepted, as before EIP1191 support was added, unless a non-zero
+// chainID is given: mixed case input must then carry a valid EIP1191
+// checksum.  Use CheckETHChecksum to enforce EIP55 checksums.
+func CheckETHAddress(address string, chainID ...uint64) bool {
+	if len(chainID) > 0 && chainID[0] != 0 {
+		return CheckETHChecksum(address, chainID[0])
+	}
+	_, err := ethutil.NewMixedcaseAddressFromString(address)
+	return err == nil
+}
+
+// CheckETHChecksum reports whether address is a well formed hex address whose
+// mixed case, if any, is a valid checksum: EIP1191 for a non-zero chainID and
+// EIP55 otherwise.  All lower or upper case input carries no checksum and is
+// accepted.
+func CheckETHChecksum(address string, chainID uint64) bool {
 	addr, err := ethutil.NewMixedcaseAddressFromString(address)
 	if err != nil {
 		return false
 	}
+	return addr.ValidChainChecksum(chainID)
+}
+
+// ICAP returns the Direct ICAP form of the address, or the Basic form when the
+// address is too large to be expressed as a Direct ICAP.
+func (a *ETHAddress) ICAP() string {
+	addr := ethutil.HexToAddress(a.String())
+	if icap, err := ethutil.AddressToICAP(addr); err == nil {
+		return icap
+	}
+	return ethutil.AddressToBasicICAP(addr)
+}
+
+// CheckICAPAddress reports whether address is a valid Direct or Basic ICAP.
+func CheckICAPAddress(address string) bool {
+	_, err := ethutil.ICAPToAddress(address)
+	return err == nil
+}
 
-	original := strings.ToLower(addr.Original())
-	hex := strings.ToLower(addr.Address().Hex())
-	return original == hex
+func isICAPAddress(address string) bool {
+	return len(address) > 2 && strings.EqualFold(address[:2], "XE")
 }
@@ -2,6 +2,8 @@ package addressutil
 
 import (
 	"testing"
+
+	"github.com/suyhuai/addressutil/util/ethutil"
 )
 
 func TestEthAddress(t *testing.T) {
@@ -30,3 +32,98 @@ func TestEthAddress(t *testing.T) {
 	}
 
 }
+
+func TestCheckETHAddressEIP1191(t *testing.T) {
+	validAddresses := map[string]uint64{
+		"0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD": 30,
+		"0xFb6916095cA1Df60bb79ce92cE3EA74c37c5d359": 30,
+		"0x5aAeb6053F3e94c9b9A09F33669435E7EF1BEaEd": 31,
+		"0xFb6916095CA1dF60bb79CE92ce3Ea74C37c5D359": 31,
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed": 0,
+	}
+
+	for address, chainID := range validAddresses {
+		if !CheckETHAddress(address, chainID) {
+			t.Log("check address fail: ", address, chainID)
+			t.Fail()
+		}
+	}
+
+	// An RSK checksum is not a valid EIP55 checksum, which is only enforced
+	// on request.
+	rsk := "0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD"
+	if CheckETHChecksum(rsk, 0) || CheckAddress(rsk, "ETH", MainNet, WithChecksum()) {
+		t.Fail()
+	}
+	if !CheckETHAddress(rsk) || !CheckAddress(rsk, "ETH", MainNet) {
+		t.Fail()
+	}
+	if !CheckAddress(rsk, "RSK", MainNet) || CheckAddress(rsk, "RSK", TestNet) {
+		t.Fail()
+	}
+
+	// RSK regtest nodes use their own chain ID.
+	if CheckAddress(rsk, "RSK", RegTest) {
+		t.Fail()
+	}
+	if _, err := NewAddress("RSK", "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", RegTest); err != ErrUnsupportedNetwork {
+		t.Log("unexpected error", err)
+		t.Fail()
+	}
+}
+
+func TestICAPAddress(t *testing.T) {
+	icaps := map[string]string{
+		"XE7338O073KYGTWWZN0F2WZ0R8PX5ZPPZS":  "0x00c5496aEe77C1bA1f0854206A26DdA82a81D6D8",
+		"XE499OG1EH8ZZI0KXC6N83EKGT1BM97P2O7": "0x52dc504a422f0e2a9e7632a34a50f1a82f8224c7",
+	}
+
+	for icap, hex := range icaps {
+		addr, err := ethutil.ICAPToAddress(icap)
+		if err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if addr != ethutil.HexToAddress(hex) {
+			t.Log("Address mismatch", addr.Hex(), hex)
+			t.Fail()
+		}
+		if !CheckAddress(icap, "ETH", MainNet, WithICAP()) {
+			t.Log("check address fail: ", icap)
+			t.Fail()
+		}
+		if CheckAddress(icap, "ETH", MainNet) {
+			t.Log("ICAP accepted without option: ", icap)
+			t.Fail()
+		}
+	}
+
+	if encoded, err := ethutil.AddressToICAP(ethutil.HexToAddress("0x00c5496aEe77C1bA1f0854206A26DdA82a81D6D8")); err != nil || encoded != "XE7338O073KYGTWWZN0F2WZ0R8PX5ZPPZS" {
+		t.Log("ICAP mismatch", encoded, err)
+		t.Fail()
+	}
+	if CheckICAPAddress("XE7438O073KYGTWWZN0F2WZ0R8PX5ZPPZS") {
+		t.Fail()
+	}
+
+	// Direct ICAPs only cover addresses below 2^155.
+	if icap, err := ethutil.AddressToICAP(ethutil.HexToAddress("0x07ffffffffffffffffffffffffffffffffffffff")); err != nil || icap != "XE78XN3J2TOA0870SUII7VSS42QMU0NQWV" {
+		t.Log("ICAP mismatch", icap, err)
+		t.Fail()
+	}
+	if _, err := ethutil.AddressToICAP(ethutil.HexToAddress("0x0800000000000000000000000000000000000000")); err != ethutil.ErrICAPEncoding {
+		t.Log("unexpected error", err)
+		t.Fail()
+	}
+
+	for _, icap := range []string{
+		// 2^155 in Direct form.
+		"XE51XN3J2TOA0870SUII7VSS42QMU0NQWW",
+		// Letters in place of the check digits that still satisfy mod 97.
+		"XE7L38O073KYGTWWZN0F2WZ0R8PX5ZPPZS",
+	} {
+		if CheckICAPAddress(icap) {
+			t.Log("Invalid ICAP accepted", icap)
+			t.Fail()
+		}
+	}
+}
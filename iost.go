@@ -0,0 +1,76 @@
+package addressutil
+
+import (
+	"github.com/suyhuai/addressutil/util/iostutil"
+)
+
+// IOSTAddress is the base58 public key an IOST account permission is bound
+// to.  Like EOS, IOST accounts are names chosen at creation time, see
+// iostutil.CheckAccount.  CheckAddress and ParseAddress accept both account
+// names and public keys for "IOST".
+type IOSTAddress struct {
+	addr   string
+	pubKey []byte
+	algo   iostutil.Algorithm
+}
+
+// NewIOSTAddress accepts an ed25519 key value, a 32 byte ed25519 key (raw or
+// hex encoded) or any secp256k1 key input understood by NewAddress.
+func NewIOSTAddress(pubKey interface{}) (*IOSTAddress, error) {
+	algo := iostutil.Ed25519
+	pub, err := parseEd25519PubKey(pubKey)
+	if err == ErrKeyType {
+		key, _, err := parsePubKey(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		pub, algo = key.SerializeCompressed(), iostutil.Secp256k1
+	} else if err != nil {
+		return nil, err
+	}
+
+	addr, err := iostutil.EncodePublicKey(pub, algo)
+	if err != nil {
+		return nil, ErrPublicKeyFormat
+	}
+
+	return &IOSTAddress{
+		addr:   addr,
+		pubKey: pub,
+		algo:   algo,
+	}, nil
+}
+
+// ParseIOSTAddress parses a base58 encoded secp256k1 or ed25519 public key.
+func ParseIOSTAddress(address string) (*IOSTAddress, error) {
+	pub, algo, err := iostutil.DecodePublicKey(address)
+	if err != nil {
+		return nil, ErrAddressFormat
+	}
+
+	return &IOSTAddress{
+		addr:   address,
+		pubKey: pub,
+		algo:   algo,
+	}, nil
+}
+
+// CheckIOSTAddress reports whether address is a valid IOST public key.
+// Funds are sent to account names, which CheckAddress checks, not to keys.
+func CheckIOSTAddress(address string) bool {
+	_, err := ParseIOSTAddress(address)
+	return err == nil
+}
+
+func (a *IOSTAddress) String() string {
+	return a.addr
+}
+
+func (a *IOSTAddress) Url() string {
+	return a.String()
+}
+
+// Algorithm returns the signature algorithm of the key.
+func (a *IOSTAddress) Algorithm() iostutil.Algorithm {
+	return a.algo
+}
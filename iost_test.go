@@ -0,0 +1,84 @@
+package addressutil
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/suyhuai/addressutil/ecc"
+	"github.com/suyhuai/addressutil/util/iostutil"
+)
+
+func TestIOSTAddress(t *testing.T) {
+	seed, _ := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	edPub := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+	_, secpPub := ecc.PrivKeyFromBytes(ecc.S256(), seed)
+
+	keys := map[iostutil.Algorithm]interface{}{
+		iostutil.Ed25519:   edPub,
+		iostutil.Secp256k1: secpPub.SerializeUncompressed(),
+	}
+
+	for algo, key := range keys {
+		a, err := NewAddress("IOST", key, MainNet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub, decodedAlgo, err := iostutil.DecodePublicKey(a.String())
+		if err != nil || decodedAlgo != algo {
+			t.Log("decode public key fail: ", a, err)
+			t.Fail()
+		}
+		if algo == iostutil.Ed25519 && !bytes.Equal(pub, edPub) {
+			t.Fail()
+		}
+		if algo == iostutil.Secp256k1 && !bytes.Equal(pub, secpPub.SerializeCompressed()) {
+			t.Fail()
+		}
+
+		if !CheckIOSTAddress(a.String()) {
+			t.Log("check key fail: ", a)
+			t.Fail()
+		}
+		if parsed, err := ParseIOSTAddress(a.String()); err != nil || parsed.Algorithm() != algo {
+			t.Log("parse key fail: ", a, err)
+			t.Fail()
+		}
+		// Funds are sent to accounts, CheckAddress only accepts their names.
+		if CheckAddress(a.String(), "IOST", MainNet) {
+			t.Log("key accepted as account: ", a)
+			t.Fail()
+		}
+	}
+
+	if CheckAddress("4Nd1mBQtrMJVYVfKf2PJy9NZUZdTAsp7D4xWLs4gDB4T", "IOST", MainNet) {
+		t.Log("solana address accepted as IOST account")
+		t.Fail()
+	}
+
+	// 32 byte keys are ed25519 keys whether raw or hex encoded.
+	raw, err := NewAddress("IOST", []byte(edPub), MainNet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, err := NewAddress("IOST", hex.EncodeToString(edPub), MainNet); err != nil || a.String() != raw.String() {
+		t.Log("Address mismatch", a, raw, err)
+		t.Fail()
+	}
+}
+
+func TestCheckIOSTAccount(t *testing.T) {
+	for _, account := range []string{"admin", "iost_1", "abcdefghijk"} {
+		if !CheckAddress(account, "IOST", MainNet) {
+			t.Log("check account fail: ", account)
+			t.Fail()
+		}
+	}
+	for _, account := range []string{"abc", "Admin", "abcdefghijkl", "iost.1"} {
+		if CheckAddress(account, "IOST", MainNet) {
+			t.Log("invalid account accepted: ", account)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package addressutil
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/suyhuai/addressutil/ecc"
+)
+
+func TestSLIP10Ed25519(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	tests := []struct {
+		path      string
+		chainCode string
+		private   string
+		public    string
+	}{
+		{"m", "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed"},
+		{"m/0'", "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c"},
+		{"m/0H/1H/2H/2H/1000000000H", "68789923a0cac2cd5a29172a475fe9e0fb14cd6adb5ad98a3fa70333e7afa230", "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793", "3c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a"},
+	}
+
+	master, err := ecc.NewEd25519MasterKey(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		k, err := master.DerivePath(test.path)
+		if err != nil {
+			t.Log(test.path, err)
+			t.Fail()
+			continue
+		}
+		priv := k.PrivateKey()
+		if got := hex.EncodeToString(k.ChainCode()); got != test.chainCode {
+			t.Log("chain code mismatch", test.path, got)
+			t.Fail()
+		}
+		if got := hex.EncodeToString(priv.Seed()); got != test.private {
+			t.Log("private key mismatch", test.path, got)
+			t.Fail()
+		}
+		if got := hex.EncodeToString(priv.Public().Bytes()); got != test.public {
+			t.Log("public key mismatch", test.path, got)
+			t.Fail()
+		}
+	}
+
+	if _, err := master.DerivePath("m/0"); err != ecc.ErrNonHardenedChild {
+		t.Log("unexpected error", err)
+		t.Fail()
+	}
+}
+
+func TestNewAddressKeyType(t *testing.T) {
+	seed, _ := hex.DecodeString("2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7")
+	priv, _ := ecc.NewEd25519PrivateKey(seed)
+	edKeys := []interface{}{
+		priv,
+		priv.PubKey(),
+		ed25519.PrivateKey(priv),
+		ed25519.PublicKey(priv.PubKey()),
+	}
+
+	for _, chain := range []string{"BTC", "LTC", "ETH", "TRON", "VDS", "ZEC"} {
+		for _, key := range edKeys {
+			if _, err := NewAddress(chain, key, MainNet); err != ErrKeyType {
+				t.Log("unexpected error", chain, key, err)
+				t.Fail()
+			}
+		}
+	}
+
+	for _, chain := range []string{"XRP", "IOST", "ADA", "XLM", "ALGO", "XTZ"} {
+		want, err := NewAddress(chain, priv.PubKey(), MainNet)
+		if err != nil {
+			t.Fatal(chain, err)
+		}
+		for _, key := range edKeys {
+			if a, err := NewAddress(chain, key, MainNet); err != nil || a.String() != want.String() {
+				t.Log("Address mismatch", chain, a, want, err)
+				t.Fail()
+			}
+		}
+	}
+}
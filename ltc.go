@@ -2,34 +2,43 @@ package addressutil
 
 import (
 	"github.com/suyhuai/addressutil/util"
-	"github.com/suyhuai/addressutil/util/ltcutil"
 	"github.com/suyhuai/addressutil/util/ltcutil/chaincfg"
 )
 
+// LTCNet is the base58 version byte of a Litecoin P2PKH address.
+//
+// Deprecated: networks are selected by Network, their address magics live in
+// the chaincfg params.
 type LTCNet uint8
 
+// Deprecated: use Network and chaincfg.MainNetParams.
 const LTC_MAIN_NET LTCNet = 0x30
+
+// Deprecated: use Network and chaincfg.TestNet4Params.
 const LTC_TEST_NET LTCNet = 0x6f
 
 type LTCAddress struct {
 	Address
 
-	net    LTCNet
+	params *util.Params
 	addr   string
 	pubKey []byte
 }
 
-func NewLTCAddress(pubKey []byte, main bool) (*LTCAddress, error) {
-	var net LTCNet
-	if main {
-		net = LTC_MAIN_NET
-	} else {
-		net = LTC_TEST_NET
+func NewLTCAddress(pubKey interface{}, net Network) (*LTCAddress, error) {
+	_, serialized, err := parsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := ltcNetParams(net)
+	if err != nil {
+		return nil, err
 	}
 
 	return &LTCAddress{
-		net:    net,
-		pubKey: pubKey,
+		params: params,
+		pubKey: serialized,
 	}, nil
 }
 
@@ -39,7 +48,7 @@ func (a *LTCAddress) String() string {
 	}
 
 	ba := &BTCAddress{
-		net:    BTCNet(a.net),
+		params: a.params,
 		pubKey: a.pubKey,
 	}
 
@@ -52,16 +61,27 @@ func (a *LTCAddress) Url() string {
 	return a.String()
 }
 
-func CheckLTCAddress(address string, main bool) bool {
-	var netParam *util.Params
-	if main {
-		netParam = &chaincfg.MainNetParams
-	} else {
-		netParam = &chaincfg.TestNet4Params
-	}
-	addr, err := ltcutil.DecodeAddress(address, netParam)
+func CheckLTCAddress(address string, net Network) bool {
+	params, err := ltcNetParams(net)
 	if err != nil {
 		return false
 	}
-	return addr.IsForNet(netParam)
+	return checkUTXOAddress(address, params)
+}
+
+// ltcNetParams returns the chain parameters of the Litecoin network net.  The
+// public Litecoin testnet is testnet4, so TestNet and TestNet4 are the same.
+func ltcNetParams(net Network) (*util.Params, error) {
+	switch net {
+	case MainNet:
+		return &chaincfg.MainNetParams, nil
+	case TestNet, TestNet4:
+		return &chaincfg.TestNet4Params, nil
+	case RegTest:
+		return &chaincfg.RegressionNetParams, nil
+	case SimNet:
+		return &chaincfg.SimNetParams, nil
+	default:
+		return nil, ErrUnsupportedNetwork
+	}
 }
@@ -12,7 +12,7 @@ func TestLTCAddress(t *testing.T) {
 
 	for addr, pubKey := range keys {
 		pub, _ := hex.DecodeString(pubKey)
-		if a, err := NewLTCAddress(pub, true); err != nil {
+		if a, err := NewLTCAddress(pub, MainNet); err != nil {
 			t.Log(err)
 			t.Fail()
 		} else if a.String() != addr {
@@ -25,22 +25,25 @@ func TestLTCAddress(t *testing.T) {
 
 func TestCheckLTCAddress(t *testing.T) {
 	validAddresses := []string{
-		"LiPgsBxvBBDR6TaTYnUvwML7rb3dTbMECK", // 普通地址
-		"MLro9kXkvYRe4nHfCnWmaW94gHr6GgrqnL", // 从3开头的编码而得，可以和3开头的相互转换
+		"LiPgsBxvBBDR6TaTYnUvwML7rb3dTbMECK",          // 普通地址
+		"MLro9kXkvYRe4nHfCnWmaW94gHr6GgrqnL",          // 从3开头的编码而得，可以和3开头的相互转换
+		"ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9", // 隔离见证地址
 	}
 	invalidAddresses := []string{
 		"LiPgsBxvBBDR6TaTYnUvwML7rb3dTbMECL",
 		"MLro9kXkvYRe4nHfCnWmaW94gHr6GgrqnM",
+		"ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n8",
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
 	}
 
 	for _, address := range validAddresses {
-		if !CheckLTCAddress(address, true) {
+		if !CheckLTCAddress(address, MainNet) {
 			t.Fail()
 		}
 	}
 
 	for _, address := range invalidAddresses {
-		if CheckLTCAddress(address, true) {
+		if CheckLTCAddress(address, MainNet) {
 			t.Fail()
 		}
 	}
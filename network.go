@@ -0,0 +1,36 @@
+package addressutil
+
+import "errors"
+
+// Network selects which network of a chain an address is generated for or
+// checked against.  Chains without separate networks ignore it.
+type Network int
+
+const (
+	MainNet Network = iota
+	TestNet
+	RegTest
+	SigNet
+	TestNet4
+	ChipNet
+	SimNet
+)
+
+var ErrUnsupportedNetwork = errors.New("unsupported network")
+
+var networkNames = map[Network]string{
+	MainNet:  "mainnet",
+	TestNet:  "testnet",
+	RegTest:  "regtest",
+	SigNet:   "signet",
+	TestNet4: "testnet4",
+	ChipNet:  "chipnet",
+	SimNet:   "simnet",
+}
+
+func (n Network) String() string {
+	if name, ok := networkNames[n]; ok {
+		return name
+	}
+	return "unknown"
+}
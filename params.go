@@ -0,0 +1,52 @@
+package addressutil
+
+import (
+	"github.com/suyhuai/addressutil/util"
+	"github.com/suyhuai/addressutil/util/utxoutil"
+)
+
+// Chains registered at runtime through util.LoadParams or util.Register are
+// looked up by their ticker and the name of the requested network.  They share
+// the UTXO address codec with the built in chains.
+
+func lookupParams(chain string, net Network) (*util.Params, bool) {
+	return util.LookupParams(chain, net.String())
+}
+
+func newParamsAddress(pubKey interface{}, params *util.Params) (*BTCAddress, error) {
+	_, serialized, err := parsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BTCAddress{
+		params: params,
+		pubKey: serialized,
+	}, nil
+}
+
+// checkUTXOAddress reports whether address is a valid address of the UTXO
+// chain network described by params.
+func checkUTXOAddress(address string, params *util.Params) bool {
+	addr, err := utxoutil.DecodeAddress(address, params)
+	if err != nil {
+		return false
+	}
+	return addr.IsForNet(params)
+}
+
+// decodeBase58Address decodes the base58 P2PKH or P2SH address address of the
+// network described by params.  Unlike utxoutil.DecodeAddress it rejects the
+// other encodings, such as hex public keys.
+func decodeBase58Address(address string, params *util.Params) (utxoutil.Address, error) {
+	addr, err := utxoutil.DecodeAddress(address, params)
+	if err != nil {
+		return nil, ErrAddressFormat
+	}
+	switch addr.(type) {
+	case *utxoutil.AddressPubKeyHash, *utxoutil.AddressScriptHash:
+		return addr, nil
+	default:
+		return nil, ErrAddressFormat
+	}
+}
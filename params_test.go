@@ -0,0 +1,161 @@
+package addressutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/suyhuai/addressutil/util"
+)
+
+func TestLoadParams(t *testing.T) {
+	pubKey := "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+
+	btg := `
+chain: BTG
+name: mainnet
+defaultPort: "8338"
+bech32HRPSegwit: btg
+pubKeyHashAddrID: 38
+scriptHashAddrID: 23
+privateKeyID: 128
+hdPrivateKeyID: 0488ade4
+hdPublicKeyID: 0488b21e
+hdCoinType: 156
+`
+	if _, err := util.LoadParams(strings.NewReader(btg)); err != nil {
+		t.Fatal(err)
+	}
+	if a, err := NewAddress("BTG", pubKey, MainNet); err != nil {
+		t.Fatal(err)
+	} else if a.String() != "GUXByHDZLvU4DnVH9imSFckt3HEQ5cFgE5" {
+		t.Log("Address mismatch", a)
+		t.Fail()
+	}
+	if !CheckAddress("GUXByHDZLvU4DnVH9imSFckt3HEQ5cFgE5", "BTG", MainNet) {
+		t.Log("check BTG address fail")
+		t.Fail()
+	}
+	if CheckAddress("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "BTG", MainNet) {
+		t.Log("bitcoin address accepted as BTG")
+		t.Fail()
+	}
+
+	xec := `{
+	"chain": "XEC",
+	"name": "mainnet",
+	"cashAddressPrefix": "ecash",
+	"legacyPubKeyHashAddrID": 0,
+	"legacyScriptHashAddrID": 5,
+	"hdCoinType": 899
+}`
+	if _, err := util.LoadParams(strings.NewReader(xec)); err != nil {
+		t.Fatal(err)
+	}
+	if !CheckAddress("ecash:qp63uahgrxged4z5jswyt5dn5v3lzsem6cacy2kzvq", "XEC", MainNet) {
+		t.Log("check XEC address fail")
+		t.Fail()
+	}
+	if CheckAddress("bitcoincash:qp63uahgrxged4z5jswyt5dn5v3lzsem6cy4spdc2h", "XEC", MainNet) {
+		t.Log("BCH address accepted as XEC")
+		t.Fail()
+	}
+
+	flux := `
+chain: FLUX
+name: mainnet
+pubKeyHashAddrPrefix: 1cb8
+scriptHashAddrPrefix: 1cbd
+`
+	if _, err := util.LoadParams(strings.NewReader(flux)); err != nil {
+		t.Fatal(err)
+	}
+	if a, err := NewAddress("FLUX", pubKey, MainNet); err != nil {
+		t.Fatal(err)
+	} else if a.String() != "t1UYsZVJkLPeMjxEtACvSxfWuNmddpWfxzs" {
+		t.Log("Address mismatch", a)
+		t.Fail()
+	}
+	if !CheckAddress("t3VEtV2oBtHxjq7wKHJb3PHsqXHvMRgUmVw", "FLUX", MainNet) {
+		t.Log("check FLUX P2SH address fail")
+		t.Fail()
+	}
+	if CheckAddress("tmLPctKo9j49rtCSKpwEBpLBeykiTGomGQs", "FLUX", MainNet) {
+		t.Log("testnet address accepted as FLUX")
+		t.Fail()
+	}
+
+	if _, err := util.LoadParams(strings.NewReader(btg)); err != util.ErrDuplicateNet {
+		t.Log("unexpected error: ", err)
+		t.Fail()
+	}
+	if _, err := util.LoadParams(strings.NewReader("name: mainnet")); err != util.ErrParamsFormat {
+		t.Log("unexpected error: ", err)
+		t.Fail()
+	}
+	// Params without address IDs would accept Bitcoin addresses.
+	if _, err := util.LoadParams(strings.NewReader("chain: FOO\nname: mainnet")); err != util.ErrParamsFormat {
+		t.Log("unexpected error: ", err)
+		t.Fail()
+	}
+	for _, config := range []string{
+		// No P2SH version, which would default to Bitcoin's P2PKH byte.
+		"chain: QQQ\nname: mainnet\npubKeyHashAddrID: 38",
+		"chain: QQQ\nname: mainnet\npubKeyHashAddrID: 0\nscriptHashAddrID: 0",
+		"chain: QQQ\nname: mainnet\npubKeyHashAddrPrefix: 1cb8",
+		"chain: QQQ\nname: mainnet\npubKeyHashAddrPrefix: 1cb8\nscriptHashAddrPrefix: 1cb8",
+		// Not a network name, LookupParams could never find it.
+		"chain: QQQ\nname: main\npubKeyHashAddrID: 38\nscriptHashAddrID: 23",
+	} {
+		if _, err := util.LoadParams(strings.NewReader(config)); err != util.ErrParamsFormat {
+			t.Log("unexpected error: ", config, err)
+			t.Fail()
+		}
+	}
+	if _, err := NewAddress("QQQ", pubKey, MainNet); err == nil {
+		t.Log("rejected params registered")
+		t.Fail()
+	}
+	for n := range networkNames {
+		if !util.IsNetworkName(n.String()) {
+			t.Log("network name unknown to util: ", n)
+			t.Fail()
+		}
+	}
+	if _, err := util.LoadParams(strings.NewReader("chain: FOO\nname: mainnet\npubKeyHashAddrId: 38")); err == nil {
+		t.Log("misspelled key accepted")
+		t.Fail()
+	}
+	for _, chain := range []string{"BTC", "doge", "ETH", "ATOM", "DOT"} {
+		config := "chain: " + chain + "\nname: signet\npubKeyHashAddrID: 38\nscriptHashAddrID: 23"
+		if _, err := util.LoadParams(strings.NewReader(config)); err != util.ErrDuplicateChain {
+			t.Log("unexpected error: ", chain, err)
+			t.Fail()
+		}
+	}
+
+	// Chains share one namespace across the built in chains, the EVM, Cosmos
+	// and Substrate registries and loaded params.
+	if err := RegisterCosmosChain("BTC", "btc"); err != ErrDuplicateChain {
+		t.Log("unexpected error: ", err)
+		t.Fail()
+	}
+	if err := RegisterSubstrateChain("ATOM", 0); err != ErrDuplicateChain {
+		t.Log("unexpected error: ", err)
+		t.Fail()
+	}
+	if err := RegisterEVMChain("BTG", EVMChain{ChainID: 1}); err != ErrDuplicateChain {
+		t.Log("unexpected error: ", err)
+		t.Fail()
+	}
+	if err := RegisterCosmosChain("INJ", "inj"); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterEVMChain("INJ", EVMChain{ChainID: 1}); err != ErrDuplicateChain {
+		t.Log("unexpected error: ", err)
+		t.Fail()
+	}
+	if _, err := util.LoadParams(strings.NewReader("chain: INJ\nname: mainnet\npubKeyHashAddrID: 38\nscriptHashAddrID: 23")); err != util.ErrDuplicateChain {
+		t.Log("unexpected error: ", err)
+		t.Fail()
+	}
+}
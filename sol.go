@@ -0,0 +1,69 @@
+package addressutil
+
+import (
+	"github.com/suyhuai/addressutil/util/solutil"
+)
+
+// SOLAddress is a Solana address: the base58 encoding of an ed25519 public
+// key, or of a program derived address.
+type SOLAddress struct {
+	addr   string
+	pubKey []byte
+}
+
+func NewSOLAddress(pubKey interface{}) (*SOLAddress, error) {
+	pub, err := parseEd25519PubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := solutil.EncodeAddress(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SOLAddress{
+		addr:   addr,
+		pubKey: pub,
+	}, nil
+}
+
+func (a *SOLAddress) String() string {
+	return a.addr
+}
+
+func (a *SOLAddress) Url() string {
+	return a.String()
+}
+
+// AssociatedTokenAddress returns the associated token account of a for the
+// token mint, owned by the token program tokenProgramID, usually
+// solutil.TokenProgramID.
+func (a *SOLAddress) AssociatedTokenAddress(mint, tokenProgramID string) (*SOLAddress, error) {
+	wallet, err := solutil.DecodeAddress(a.addr)
+	if err != nil {
+		return nil, err
+	}
+	mintKey, err := solutil.DecodeAddress(mint)
+	if err != nil {
+		return nil, err
+	}
+
+	ata, _, err := solutil.FindAssociatedTokenAddress(wallet, mintKey, tokenProgramID)
+	if err != nil {
+		return nil, err
+	}
+	addr, _ := solutil.EncodeAddress(ata)
+	return &SOLAddress{addr: addr}, nil
+}
+
+// CheckSOLAddress reports whether address is a 32 byte Solana address.
+// Unless offCurve is set it must also be an ed25519 point, which rules out
+// program derived addresses such as token accounts.
+func CheckSOLAddress(address string, offCurve bool) bool {
+	pub, err := solutil.DecodeAddress(address)
+	if err != nil {
+		return false
+	}
+	return offCurve || solutil.IsOnCurve(pub)
+}
@@ -0,0 +1,98 @@
+package addressutil
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/suyhuai/addressutil/ecc"
+	"github.com/suyhuai/addressutil/util/solutil"
+)
+
+func TestSOLAddress(t *testing.T) {
+	seed, _ := hex.DecodeString("2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7")
+	priv, _ := ecc.NewEd25519PrivateKey(seed)
+	keys := []interface{}{
+		priv,
+		priv.PubKey(),
+		"a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed",
+	}
+
+	for _, key := range keys {
+		if a, err := NewSOLAddress(key); err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if a.String() != "C5ukMV73nk32h52MjxtnZXTrrr7rupD9CTDDRnYYDRYQ" {
+			t.Log("Address mismatch", a)
+			t.Fail()
+		}
+	}
+
+	if _, err := NewSOLAddress("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"); err != ErrKeyType {
+		t.Log("unexpected error", err)
+		t.Fail()
+	}
+}
+
+func TestCheckSOLAddress(t *testing.T) {
+	validAddresses := []string{
+		"C5ukMV73nk32h52MjxtnZXTrrr7rupD9CTDDRnYYDRYQ",
+		"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		solutil.SystemProgramID,
+	}
+	invalidAddresses := []string{
+		"C5ukMV73nk32h52MjxtnZXTrrr7rupD9CTDD",
+		"C5ukMV73nk32h52MjxtnZXTrrr7rupD9CTDDRnYYDRYQ1",
+		"C5ukMV73nk32h52MjxtnZXTrrr7rupD9CTDDRnYYDRY0",
+		// An associated token account is not a wallet address.
+		"3mhuaN1cpNqDLP9tf54uTYg9NaWKqVhq6B5f8wTcmKjk",
+	}
+
+	for _, address := range validAddresses {
+		if !CheckAddress(address, "SOL", MainNet) {
+			t.Log("expected valid", address)
+			t.Fail()
+		}
+	}
+
+	for _, address := range invalidAddresses {
+		if CheckAddress(address, "SOL", MainNet) {
+			t.Log("expected invalid", address)
+			t.Fail()
+		}
+	}
+
+	if !CheckAddress("3mhuaN1cpNqDLP9tf54uTYg9NaWKqVhq6B5f8wTcmKjk", "SOL", MainNet, WithOffCurve()) {
+		t.Log("program derived address rejected with WithOffCurve")
+		t.Fail()
+	}
+}
+
+func TestSOLProgramAddress(t *testing.T) {
+	wallet, _ := ParseAddress("C5ukMV73nk32h52MjxtnZXTrrr7rupD9CTDDRnYYDRYQ", "SOL", MainNet)
+	ata, err := wallet.(*SOLAddress).AssociatedTokenAddress("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", solutil.TokenProgramID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ata.String() != "3mhuaN1cpNqDLP9tf54uTYg9NaWKqVhq6B5f8wTcmKjk" {
+		t.Log("associated token address mismatch", ata)
+		t.Fail()
+	}
+
+	program, _ := solutil.DecodeAddress(solutil.TokenProgramID)
+	addr, bump, err := solutil.FindProgramAddress([][]byte{[]byte("metadata")}, program)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encoded, _ := solutil.EncodeAddress(addr); encoded != "3ijiZDfPJaxmakuhGZj8MYAVxxxmtprvF7mR85XJMmd6" || bump != 254 {
+		t.Log("program address mismatch", encoded, bump)
+		t.Fail()
+	}
+	if _, err := solutil.CreateProgramAddress([][]byte{[]byte("metadata"), {bump}}, program); err != nil {
+		t.Log(err)
+		t.Fail()
+	}
+	if _, err := solutil.CreateProgramAddress([][]byte{make([]byte, solutil.MaxSeedLength+1)}, program); err != solutil.ErrMaxSeedLength {
+		t.Log("unexpected error", err)
+		t.Fail()
+	}
+}
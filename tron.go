@@ -3,24 +3,75 @@ package addressutil
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
 	"github.com/suyhuai/addressutil/base58"
+	"github.com/suyhuai/addressutil/util/ethutil"
 	"golang.org/x/crypto/sha3"
 )
 
+// tronAddrPrefix is the leading byte of every TRON address, rendered as "41"
+// in hex and as "T" in base58.
+const tronAddrPrefix = 0x41
+
+var ErrTRONAddressFormat = errors.New("TRON address format error")
+
 type TRONAddress struct {
 	addr   string
 	pubKey []byte
+	evm    ethutil.Address
 }
 
-func NewTRONAddress(pubKey []byte) (*TRONAddress, error) {
+func NewTRONAddress(pubKey interface{}) (*TRONAddress, error) {
+	pub, _, err := parsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	uncompressed := pub.SerializeUncompressed()
 	address := &TRONAddress{
-		pubKey: pubKey[1:],
-		addr:   tronAddrFromPub(pubKey),
+		pubKey: uncompressed[1:],
+		addr:   tronAddrFromPub(uncompressed),
+		evm:    tronEVMFromPub(uncompressed),
 	}
 
 	return address, nil
 }
 
+// NewTRONAddressFromEVM returns the TRON address sharing the 20 byte account
+// of the given EVM address.
+func NewTRONAddressFromEVM(evm ethutil.Address) *TRONAddress {
+	return &TRONAddress{
+		addr: tronEncode(evm[:]),
+		evm:  evm,
+	}
+}
+
+// ParseTRONAddress parses a TRON address in its base58 ("T…"), hex ("41…") or
+// 20 byte EVM ("0x…") form.
+func ParseTRONAddress(address string) (*TRONAddress, error) {
+	switch {
+	case len(address) == 2*(ethutil.AddressLength+1) && strings.HasPrefix(address, "41"):
+		raw, err := hex.DecodeString(address)
+		if err != nil {
+			return nil, ErrTRONAddressFormat
+		}
+		return NewTRONAddressFromEVM(ethutil.BytesToAddress(raw[1:])), nil
+	case ethutil.IsHexAddress(address):
+		return NewTRONAddressFromEVM(ethutil.HexToAddress(address)), nil
+	case CheckTRONAddress(address):
+		rawAddr := base58.Decode(address)
+		return &TRONAddress{
+			addr: address,
+			evm:  ethutil.BytesToAddress(rawAddr[1:21]),
+		}, nil
+	default:
+		return nil, ErrTRONAddressFormat
+	}
+}
+
 func (t *TRONAddress) String() string {
 	return t.addr
 }
@@ -29,7 +80,21 @@ func (t *TRONAddress) Url() string {
 	return t.String()
 }
 
+// Hex returns the "41" prefixed hex form used by the TRON node APIs.
+func (t *TRONAddress) Hex() string {
+	return hex.EncodeToString(append([]byte{tronAddrPrefix}, t.evm[:]...))
+}
+
+// EVMAddress returns the 20 byte account used by TRON smart contracts.
+func (t *TRONAddress) EVMAddress() ethutil.Address {
+	return t.evm
+}
+
 func tronAddrFromPub(pub []byte) string {
+	return tronEncode(tronEVMFromPub(pub).Bytes())
+}
+
+func tronEVMFromPub(pub []byte) ethutil.Address {
 	// #1 取公钥仅包含x，y坐标的64字节的byte数组
 	pubBytes := pub[1:]
 
@@ -37,10 +102,12 @@ func tronAddrFromPub(pub []byte) string {
 	hash := sha3.NewLegacyKeccak256()
 	hash.Write(pubBytes)
 	hashed := hash.Sum(nil)
-	last20 := hashed[len(hashed)-20:]
+	return ethutil.BytesToAddress(hashed[len(hashed)-20:])
+}
 
+func tronEncode(last20 []byte) string {
 	// #3
-	addr41 := append([]byte{0x41}, last20...)
+	addr41 := append([]byte{tronAddrPrefix}, last20...)
 
 	// #4
 	hash2561 := sha256.Sum256(addr41)
@@ -59,7 +126,7 @@ func CheckTRONAddress(base58Addr string) bool {
 	if len(rawAddr) != 25 || len(rawAddr) == 0 {
 		return false
 	}
-	if rawAddr[0] != 0x41 {
+	if rawAddr[0] != tronAddrPrefix {
 		return false
 	}
 
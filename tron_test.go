@@ -0,0 +1,56 @@
+package addressutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/suyhuai/addressutil/util/ethutil"
+)
+
+func TestParseTRONAddress(t *testing.T) {
+	addresses := map[string]string{
+		"TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL": "418840e6c55b9ada326d211d818c34a994aeced808",
+		"TJCnKsPa7y5okkXvQAidZBzqx3QyQ6sxMW": "415a523b449890854c8fc460ab602df9f31fe4293f",
+	}
+
+	for base58Addr, hexAddr := range addresses {
+		for _, input := range []string{base58Addr, hexAddr, "0x" + hexAddr[2:]} {
+			a, err := ParseTRONAddress(input)
+			if err != nil {
+				t.Log(input, err)
+				t.Fail()
+				continue
+			}
+			if a.String() != base58Addr || a.Hex() != hexAddr {
+				t.Log("Address mismatch", input, a, a.Hex())
+				t.Fail()
+			}
+			if a.EVMAddress() != ethutil.HexToAddress(hexAddr[2:]) {
+				t.Log("EVM address mismatch", input, a.EVMAddress().Hex())
+				t.Fail()
+			}
+		}
+	}
+
+	if _, err := ParseTRONAddress("TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeM"); err != ErrTRONAddressFormat {
+		t.Fail()
+	}
+}
+
+func TestTRONAddressEVM(t *testing.T) {
+	for addr, pubKey := range map[string][]byte{
+		"0x374502b5B1e5fA90640Acc72788f7B4fA266A3d0": {0x04, 0xb9, 0xdf, 0x94, 0xef, 0xc1, 0x0e, 0xcc, 0xc2, 0x5b, 0x91, 0xfe, 0xa4, 0x18, 0x56, 0x5d, 0x86, 0x29, 0x81, 0x50, 0x96, 0xa5, 0x14, 0xf5, 0x14, 0x0b, 0xbf, 0xb3, 0xbe, 0xe9, 0x39, 0x4d, 0x29, 0xc5, 0xe9, 0x25, 0xf1, 0x20, 0x10, 0x4f, 0x52, 0xc4, 0x8e, 0x15, 0xc9, 0x37, 0x21, 0x25, 0xb9, 0x7e, 0xc6, 0x20, 0x1f, 0x13, 0xb1, 0xde, 0xd3, 0xd5, 0x0c, 0xce, 0xe2, 0xc2, 0x7b, 0x66, 0xe3},
+	} {
+		a, err := NewTRONAddress(pubKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.EVMAddress().Hex() != addr || a.Hex() != "41"+strings.ToLower(addr[2:]) {
+			t.Log("Address mismatch", a.EVMAddress().Hex(), a.Hex(), addr)
+			t.Fail()
+		}
+		if NewTRONAddressFromEVM(a.EVMAddress()).String() != a.String() {
+			t.Fail()
+		}
+	}
+}
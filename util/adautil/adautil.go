@@ -0,0 +1,255 @@
+package adautil
+
+import (
+	"errors"
+
+	"github.com/suyhuai/addressutil/bech32"
+	"github.com/suyhuai/addressutil/blake2b"
+)
+
+// HashSize is the length of the blake2b-224 hash of a key or script.
+const HashSize = 28
+
+// Network tags of the address header.  Every test network uses TestNet.
+const (
+	TestNet byte = 0
+	MainNet byte = 1
+)
+
+// maxLength is the longest bech32 string whose checksum is guaranteed to
+// detect errors, which bounds the length of pointer addresses.
+const maxLength = 1023
+
+// Kind is the shape of a Shelley address.
+type Kind int
+
+const (
+	// Base addresses carry a payment and a stake credential.
+	Base Kind = iota
+	// Pointer addresses carry a payment credential and the position of the
+	// certificate that registered the stake credential.
+	Pointer
+	// Enterprise addresses carry only a payment credential.
+	Enterprise
+	// Reward addresses carry only a stake credential.
+	Reward
+)
+
+// Address header types, the upper four bits of the first byte.  The low
+// bits of a type pick script credentials, see header.
+const (
+	headerBase       = 0x0
+	headerPointer    = 0x4
+	headerEnterprise = 0x6
+	headerReward     = 0xe
+)
+
+var (
+	ErrAddressFormat = errors.New("invalid Cardano address")
+	ErrHashSize      = errors.New("invalid Cardano credential hash size")
+)
+
+// Credential is the hash of the verification key or script that controls
+// the payment or stake part of an address.
+type Credential struct {
+	Hash   []byte
+	Script bool
+}
+
+// KeyCredential returns the credential of the ed25519 verification key pub.
+func KeyCredential(pub []byte) Credential {
+	return Credential{Hash: KeyHash(pub)}
+}
+
+// KeyHash returns the blake2b-224 hash of the verification key pub.
+func KeyHash(pub []byte) []byte {
+	return blake2b.Sum224(pub)
+}
+
+// ChainPointer locates a stake registration certificate on chain.
+type ChainPointer struct {
+	Slot      uint64
+	TxIndex   uint64
+	CertIndex uint64
+}
+
+// Address is a decoded Shelley address.  Payment is unused by Reward
+// addresses, Stake by Pointer and Enterprise addresses and Pointer by all
+// but Pointer addresses.
+type Address struct {
+	Kind    Kind
+	Network byte
+	Payment Credential
+	Stake   Credential
+	Pointer ChainPointer
+}
+
+// HRP returns the human-readable part of the bech32 form of a.
+func (a *Address) HRP() string {
+	hrp := "addr"
+	if a.Kind == Reward {
+		hrp = "stake"
+	}
+	if a.Network != MainNet {
+		hrp += "_test"
+	}
+	return hrp
+}
+
+// Bytes returns the binary form of a: its header followed by its
+// credentials.
+func (a *Address) Bytes() ([]byte, error) {
+	if a.Network > 0x0f {
+		return nil, ErrAddressFormat
+	}
+
+	var typ byte
+	var creds []Credential
+	switch a.Kind {
+	case Base:
+		typ = headerBase | flag(a.Payment.Script, 0) | flag(a.Stake.Script, 1)
+		creds = []Credential{a.Payment, a.Stake}
+	case Pointer:
+		typ = headerPointer | flag(a.Payment.Script, 0)
+		creds = []Credential{a.Payment}
+	case Enterprise:
+		typ = headerEnterprise | flag(a.Payment.Script, 0)
+		creds = []Credential{a.Payment}
+	case Reward:
+		typ = headerReward | flag(a.Stake.Script, 0)
+		creds = []Credential{a.Stake}
+	default:
+		return nil, ErrAddressFormat
+	}
+
+	b := []byte{typ<<4 | a.Network}
+	for _, c := range creds {
+		if len(c.Hash) != HashSize {
+			return nil, ErrHashSize
+		}
+		b = append(b, c.Hash...)
+	}
+	if a.Kind == Pointer {
+		b = appendNat(b, a.Pointer.Slot)
+		b = appendNat(b, a.Pointer.TxIndex)
+		b = appendNat(b, a.Pointer.CertIndex)
+	}
+	return b, nil
+}
+
+// Encode returns the bech32 form of a.
+func (a *Address) Encode() (string, error) {
+	b, err := a.Bytes()
+	if err != nil {
+		return "", err
+	}
+	data, err := bech32.ConvertBits(b, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.Encode(a.HRP(), data)
+}
+
+// Decode parses the bech32 Shelley address addr.  Byron addresses are not
+// supported.
+func Decode(addr string) (*Address, error) {
+	hrp, data, err := bech32.DecodeWithLimit(addr, maxLength)
+	if err != nil {
+		return nil, err
+	}
+	b, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+	a, err := FromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != a.HRP() {
+		return nil, ErrAddressFormat
+	}
+	return a, nil
+}
+
+// FromBytes parses the binary form of a Shelley address.
+func FromBytes(b []byte) (*Address, error) {
+	if len(b) < 1+HashSize {
+		return nil, ErrAddressFormat
+	}
+
+	typ := b[0] >> 4
+	a := &Address{Network: b[0] & 0x0f}
+	rest := b[1:]
+	switch {
+	case typ <= headerBase|0x3:
+		if len(rest) != 2*HashSize {
+			return nil, ErrAddressFormat
+		}
+		a.Kind = Base
+		a.Payment = Credential{Hash: rest[:HashSize], Script: typ&0x1 != 0}
+		a.Stake = Credential{Hash: rest[HashSize:], Script: typ&0x2 != 0}
+	case typ == headerPointer || typ == headerPointer|0x1:
+		a.Kind = Pointer
+		a.Payment = Credential{Hash: rest[:HashSize], Script: typ&0x1 != 0}
+		ptr := rest[HashSize:]
+		var err error
+		for _, field := range []*uint64{&a.Pointer.Slot, &a.Pointer.TxIndex, &a.Pointer.CertIndex} {
+			if *field, ptr, err = readNat(ptr); err != nil {
+				return nil, err
+			}
+		}
+		if len(ptr) != 0 {
+			return nil, ErrAddressFormat
+		}
+	case typ == headerEnterprise || typ == headerEnterprise|0x1:
+		if len(rest) != HashSize {
+			return nil, ErrAddressFormat
+		}
+		a.Kind = Enterprise
+		a.Payment = Credential{Hash: rest, Script: typ&0x1 != 0}
+	case typ == headerReward || typ == headerReward|0x1:
+		if len(rest) != HashSize {
+			return nil, ErrAddressFormat
+		}
+		a.Kind = Reward
+		a.Stake = Credential{Hash: rest, Script: typ&0x1 != 0}
+	default:
+		return nil, ErrAddressFormat
+	}
+	return a, nil
+}
+
+func flag(set bool, bit uint) byte {
+	if set {
+		return 1 << bit
+	}
+	return 0
+}
+
+// appendNat appends n as a big-endian base-128 number whose bytes but the
+// last have the high bit set.
+func appendNat(b []byte, n uint64) []byte {
+	var buf [10]byte
+	i := len(buf) - 1
+	buf[i] = byte(n & 0x7f)
+	for n >>= 7; n > 0; n >>= 7 {
+		i--
+		buf[i] = byte(n&0x7f) | 0x80
+	}
+	return append(b, buf[i:]...)
+}
+
+// readNat reads a number written by appendNat from the start of b.
+func readNat(b []byte) (uint64, []byte, error) {
+	var n uint64
+	for i, c := range b {
+		if n > (1<<64-1)>>7 {
+			return 0, nil, ErrAddressFormat
+		}
+		n = n<<7 | uint64(c&0x7f)
+		if c&0x80 == 0 {
+			return n, b[i+1:], nil
+		}
+	}
+	return 0, nil, ErrAddressFormat
+}
@@ -0,0 +1,65 @@
+package algoutil
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+)
+
+// PublicKeySize is the length of the ed25519 public key, or application
+// hash, an address encodes.
+const PublicKeySize = 32
+
+// checksumLen is the number of trailing SHA-512/256 bytes appended to the
+// key.
+const checksumLen = 4
+
+// appIDPrefix domain-separates the hash of an application ID.
+var appIDPrefix = []byte("appID")
+
+var (
+	ErrAddressFormat = errors.New("invalid Algorand address")
+	ErrChecksum      = errors.New("Algorand address checksum mismatch")
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EncodeAddress returns the address of the ed25519 public key pub: the
+// base32 encoding of pub followed by the last four bytes of its SHA-512/256
+// hash.
+func EncodeAddress(pub []byte) (string, error) {
+	if len(pub) != PublicKeySize {
+		return "", ErrAddressFormat
+	}
+	return encoding.EncodeToString(append(pub[:PublicKeySize:PublicKeySize], checksum(pub)...)), nil
+}
+
+// DecodeAddress returns the public key encoded by the address addr.
+func DecodeAddress(addr string) ([]byte, error) {
+	data, err := encoding.DecodeString(addr)
+	if err != nil || len(data) != PublicKeySize+checksumLen || encoding.EncodeToString(data) != addr {
+		return nil, ErrAddressFormat
+	}
+
+	pub := data[:PublicKeySize]
+	if !bytes.Equal(checksum(pub), data[PublicKeySize:]) {
+		return nil, ErrChecksum
+	}
+	return pub, nil
+}
+
+// ApplicationAddress returns the escrow address of the application appID,
+// the address of the SHA-512/256 hash of "appID" and its big-endian ID.
+func ApplicationAddress(appID uint64) string {
+	data := binary.BigEndian.AppendUint64(append([]byte{}, appIDPrefix...), appID)
+	hash := sha512.Sum512_256(data)
+	addr, _ := EncodeAddress(hash[:])
+	return addr
+}
+
+func checksum(pub []byte) []byte {
+	hash := sha512.Sum512_256(pub)
+	return hash[len(hash)-checksumLen:]
+}
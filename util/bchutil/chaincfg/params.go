@@ -13,6 +13,10 @@ const (
 	TestNet  util.BitcoinNet = 0xfabfb5da
 	TestNet3 util.BitcoinNet = 0xf4f3e5f4
 	SimNet   util.BitcoinNet = 0x12141c16
+
+	// TestNet4 is shared by testnet4 and chipnet, which only differ in
+	// their upgrade activation schedule.
+	TestNet4 util.BitcoinNet = 0xafdab7e2
 )
 
 const (
@@ -26,27 +30,10 @@ var (
 	mainPowLimit       = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 224), bigOne)
 	regressionPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 255), bigOne)
 	testNet3PowLimit   = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 224), bigOne)
+	testNet4PowLimit   = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 224), bigOne)
 	simNetPowLimit     = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 255), bigOne)
 )
 
-var (
-	registeredNets      = make(map[util.BitcoinNet]struct{})
-	pubKeyHashAddrIDs   = make(map[byte]struct{})
-	scriptHashAddrIDs   = make(map[byte]struct{})
-	cashAddressPrefixes = make(map[string]struct{})
-	hdPrivToPubKeyIDs   = make(map[[4]byte][]byte)
-)
-
-func IsPubKeyHashAddrID(id byte) bool {
-	_, ok := pubKeyHashAddrIDs[id]
-	return ok
-}
-
-func IsScriptHashAddrID(id byte) bool {
-	_, ok := scriptHashAddrIDs[id]
-	return ok
-}
-
 var MainNetParams = util.Params{
 	Name:        "mainnet",
 	Net:         MainNet,
@@ -290,6 +277,90 @@ var TestNet3Params = util.Params{
 	HDCoinType: 1, // all coins use 1
 }
 
+var TestNet4Params = util.Params{
+	Name:        "testnet4",
+	Net:         TestNet4,
+	DefaultPort: "28333",
+	DNSSeeds: []util.DNSSeed{
+		{"testnet4-seed-bch.bitcoinforks.org", true},
+		{"testnet4-seed-bch.toom.im", true},
+		{"seed.tbch4.loping.net", true},
+		{"testnet4-seed.flowee.cash", true},
+	},
+
+	// Chain parameters
+	GenesisBlock:  &testNet4GenesisBlock,
+	GenesisHash:   &testNet4GenesisHash,
+	PowLimit:      testNet4PowLimit,
+	PowLimitBits:  0x1d00ffff,
+	BIP0034Height: 2,
+	BIP0065Height: 3,
+	BIP0066Height: 4,
+
+	UahfForkHeight: 6,
+	DaaForkHeight:  3000,
+
+	CoinbaseMaturity:         100,
+	SubsidyReductionInterval: 210000,
+	TargetTimespan:           time.Hour * 24 * 14, // 14 days
+	TargetTimePerBlock:       time.Minute * 10,    // 10 minutes
+	RetargetAdjustmentFactor: 4,                   // 25% less, 400% more
+	ReduceMinDifficulty:      true,
+	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
+	GenerateSupported:        false,
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints: nil,
+
+	RuleChangeActivationThreshold: 1512, // 75% of MinerConfirmationWindow
+	MinerConfirmationWindow:       2016,
+	Deployments: []util.ConsensusDeployment{
+		DeploymentTestDummy: {
+			BitNumber:  28,
+			StartTime:  0,             // Always available for vote
+			ExpireTime: math.MaxInt64, // Never expires
+		},
+		DeploymentCSV: {
+			BitNumber:  0,
+			StartTime:  0,             // Always available for vote
+			ExpireTime: math.MaxInt64, // Never expires
+		},
+	},
+
+	// Mempool parameters
+	RelayNonStdTxs: true,
+
+	// The prefix for the cashaddress
+	CashAddressPrefix: "bchtest", // always bchtest for testnet
+
+	// Address encoding magics
+	LegacyPubKeyHashAddrID: 0x6f, // starts with m or n
+	LegacyScriptHashAddrID: 0xc4, // starts with 2
+	PrivateKeyID:           0xef, // starts with 9 (uncompressed) or c (compressed)
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // starts with tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // starts with tpub
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation.
+	HDCoinType: 1, // all coins use 1
+}
+
+// ChipNetParams defines the network parameters for chipnet, the network
+// upgrades are activated on ahead of mainnet.  It uses the same network magic
+// and address encodings as testnet4, so it is not registered separately.
+var ChipNetParams = func() util.Params {
+	params := TestNet4Params
+	params.Name = "chipnet"
+	params.DefaultPort = "48333"
+	params.DNSSeeds = []util.DNSSeed{
+		{"chipnet.bitjson.com", true},
+		{"chipnet.imaginary.cash", true},
+	}
+	return params
+}()
+
 var SimNetParams = util.Params{
 	Name:        "simnet",
 	Net:         SimNet,
@@ -351,19 +422,6 @@ var SimNetParams = util.Params{
 	HDCoinType: 115, // ASCII for s
 }
 
-func Register(params *util.Params) error {
-	if _, ok := registeredNets[params.Net]; ok {
-		return util.ErrDuplicateNet
-	}
-	registeredNets[params.Net] = struct{}{}
-	pubKeyHashAddrIDs[params.LegacyPubKeyHashAddrID] = struct{}{}
-	scriptHashAddrIDs[params.LegacyScriptHashAddrID] = struct{}{}
-	hdPrivToPubKeyIDs[params.HDPrivateKeyID] = params.HDPublicKeyID[:]
-
-	cashAddressPrefixes[params.CashAddressPrefix+":"] = struct{}{}
-	return nil
-}
-
 func newHashFromStr(hexStr string) *util.Hash {
 	hash, err := util.NewHashFromStr(hexStr)
 	if err != nil {
@@ -371,16 +429,3 @@ func newHashFromStr(hexStr string) *util.Hash {
 	}
 	return hash
 }
-
-func mustRegister(params *util.Params) {
-	if err := Register(params); err != nil {
-		panic("failed to register network: " + err.Error())
-	}
-}
-
-func init() {
-	mustRegister(&MainNetParams)
-	mustRegister(&TestNet3Params)
-	mustRegister(&RegressionNetParams)
-	mustRegister(&SimNetParams)
-}
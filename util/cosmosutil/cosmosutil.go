@@ -0,0 +1,85 @@
+package cosmosutil
+
+import (
+	"errors"
+
+	"github.com/suyhuai/addressutil/bech32"
+)
+
+// AddressType selects which of the bech32 prefixes of a chain an address
+// uses.
+type AddressType int
+
+const (
+	Account AddressType = iota
+	Validator
+	Consensus
+)
+
+// The suffixes the Cosmos SDK appends to the account prefix of a chain for
+// validator operator and consensus node addresses.
+const (
+	validatorSuffix = "valoper"
+	consensusSuffix = "valcons"
+)
+
+var ErrAddressFormat = errors.New("invalid Cosmos address")
+
+// HRP returns the human-readable part of addresses of type typ on the chain
+// whose account addresses use the prefix base, such as "cosmosvaloper" for
+// Validator and "cosmos".
+func HRP(base string, typ AddressType) string {
+	switch typ {
+	case Validator:
+		return base + validatorSuffix
+	case Consensus:
+		return base + consensusSuffix
+	default:
+		return base
+	}
+}
+
+// Encode returns the bech32 address of the account bytes addr under hrp.
+// Accounts derived from a public key are 20 bytes, module and interchain
+// accounts 32.
+func Encode(hrp string, addr []byte) (string, error) {
+	if !validLength(addr) {
+		return "", ErrAddressFormat
+	}
+	data, err := bech32.ConvertBits(addr, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.Encode(hrp, data)
+}
+
+// Decode returns the human-readable part and account bytes of the bech32
+// address addr.
+func Decode(addr string) (string, []byte, error) {
+	hrp, data, err := bech32.Decode(addr)
+	if err != nil {
+		return "", nil, err
+	}
+	decoded, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+	if !validLength(decoded) {
+		return "", nil, ErrAddressFormat
+	}
+	return hrp, decoded, nil
+}
+
+// ConvertHRP re-encodes the account bytes of addr under hrp, for example to
+// turn a "cosmos1…" address into the "osmo1…" address of the same key.
+func ConvertHRP(addr, hrp string) (string, error) {
+	_, decoded, err := Decode(addr)
+	if err != nil {
+		return "", err
+	}
+	return Encode(hrp, decoded)
+}
+
+func validLength(addr []byte) bool {
+	return len(addr) == 20 || len(addr) == 32
+}
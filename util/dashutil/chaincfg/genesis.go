@@ -0,0 +1,109 @@
+package chaincfg
+
+import (
+	"time"
+
+	"github.com/suyhuai/addressutil/util"
+)
+
+// genesisCoinbaseTx is the coinbase transaction for the genesis blocks for
+// the main network and the test network.
+var genesisCoinbaseTx = util.MsgTx{
+	Version: 1,
+	TxIn: []*util.TxIn{
+		{
+			PreviousOutPoint: util.OutPoint{
+				Hash:  util.Hash{},
+				Index: 0xffffffff,
+			},
+			SignatureScript: []byte{
+				0x04, 0xff, 0xff, 0x00, 0x1d, 0x01, 0x04, 0x4c, /* |.......L| */
+				0x59, 0x57, 0x69, 0x72, 0x65, 0x64, 0x20, 0x30, /* |YWired 0| */
+				0x39, 0x2f, 0x4a, 0x61, 0x6e, 0x2f, 0x32, 0x30, /* |9.Jan.20| */
+				0x31, 0x34, 0x20, 0x54, 0x68, 0x65, 0x20, 0x47, /* |14 The G| */
+				0x72, 0x61, 0x6e, 0x64, 0x20, 0x45, 0x78, 0x70, /* |rand Exp| */
+				0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x20, /* |eriment | */
+				0x47, 0x6f, 0x65, 0x73, 0x20, 0x4c, 0x69, 0x76, /* |Goes Liv| */
+				0x65, 0x3a, 0x20, 0x4f, 0x76, 0x65, 0x72, 0x73, /* |e: Overs| */
+				0x74, 0x6f, 0x63, 0x6b, 0x2e, 0x63, 0x6f, 0x6d, /* |tock.com| */
+				0x20, 0x49, 0x73, 0x20, 0x4e, 0x6f, 0x77, 0x20, /* | Is Now | */
+				0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6e, /* |Acceptin| */
+				0x67, 0x20, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, /* |g Bitcoi| */
+				0x6e, 0x73, /* |ns| */
+			},
+			Sequence: 0xffffffff,
+		},
+	},
+	TxOut: []*util.TxOut{
+		{
+			Value: 0x12a05f200,
+			PkScript: []byte{
+				0x41, 0x04, 0x01, 0x84, 0x71, 0x0f, 0xa6, 0x89, /* |A...q...| */
+				0xad, 0x50, 0x23, 0x69, 0x0c, 0x80, 0xf3, 0xa4, /* |.P#i....| */
+				0x9c, 0x8f, 0x13, 0xf8, 0xd4, 0x5b, 0x8c, 0x85, /* |.....[..| */
+				0x7f, 0xbc, 0xbc, 0x8b, 0xc4, 0xa8, 0xe4, 0xd3, /* |........| */
+				0xeb, 0x4b, 0x10, 0xf4, 0xd4, 0x60, 0x4f, 0xa0, /* |.K...`O.| */
+				0x8d, 0xce, 0x60, 0x1a, 0xaf, 0x0f, 0x47, 0x02, /* |..`...G.| */
+				0x16, 0xfe, 0x1b, 0x51, 0x85, 0x0b, 0x4a, 0xcf, /* |...Q..J.| */
+				0x21, 0xb1, 0x79, 0xc4, 0x50, 0x70, 0xac, 0x7b, /* |!.y.Pp.{| */
+				0x03, 0xa9, 0xac, /* |...| */
+			},
+		},
+	},
+	LockTime: 0,
+}
+
+// genesisMerkleRoot is the hash of the first transaction in the genesis block
+// for the main network.
+var genesisMerkleRoot = util.Hash([util.HashSize]byte{ // Make go vet happy.
+	0xc7, 0x62, 0xa6, 0x56, 0x7f, 0x3c, 0xc0, 0x92,
+	0xf0, 0x68, 0x4b, 0xb6, 0x2b, 0x7e, 0x00, 0xa8,
+	0x48, 0x90, 0xb9, 0x90, 0xf0, 0x7c, 0xc7, 0x1a,
+	0x6b, 0xb5, 0x8d, 0x64, 0xb9, 0x8e, 0x02, 0xe0,
+})
+
+// mainGenesisHash is the hash of the first block in the block chain for the
+// main network.
+var mainGenesisHash = util.Hash([util.HashSize]byte{ // Make go vet happy.
+	0xb6, 0x7a, 0x40, 0xf3, 0xcd, 0x58, 0x04, 0x43,
+	0x7a, 0x10, 0x8f, 0x10, 0x55, 0x33, 0x73, 0x9c,
+	0x37, 0xe6, 0x22, 0x9b, 0xc1, 0xad, 0xca, 0xb3,
+	0x85, 0x14, 0x0b, 0x59, 0xfd, 0x0f, 0x00, 0x00,
+})
+
+// mainGenesisBlock defines the genesis block of the block chain which serves as
+// the public transaction ledger for the main network.
+var mainGenesisBlock = util.MsgBlock{
+	Header: util.BlockHeader{
+		Version:    1,
+		PrevBlock:  util.Hash{},              // 0000000000000000000000000000000000000000000000000000000000000000
+		MerkleRoot: genesisMerkleRoot,        // e0028eb9648db56b1ac77cf090b99048a8007e2bb64b68f092c03c7f56a662c7
+		Timestamp:  time.Unix(1390095618, 0), // 2014-01-19 01:40:18 +0000 UTC
+		Bits:       0x1e0ffff0,
+		Nonce:      28917698,
+	},
+	Transactions: []*util.MsgTx{&genesisCoinbaseTx},
+}
+
+// testNetGenesisHash is the hash of the first block in the block chain for the
+// test network.
+var testNetGenesisHash = util.Hash([util.HashSize]byte{ // Make go vet happy.
+	0x2c, 0xbc, 0xf8, 0x3b, 0x62, 0x91, 0x3d, 0x56,
+	0xf6, 0x05, 0xc0, 0xe5, 0x81, 0xa4, 0x88, 0x72,
+	0x83, 0x94, 0x28, 0xc9, 0x2e, 0x5e, 0xb7, 0x6c,
+	0xd7, 0xad, 0x94, 0xbc, 0xaf, 0x0b, 0x00, 0x00,
+})
+
+// testNetGenesisBlock defines the genesis block of the block chain which serves as
+// the public transaction ledger for the test network.
+var testNetGenesisBlock = util.MsgBlock{
+	Header: util.BlockHeader{
+		Version:    1,
+		PrevBlock:  util.Hash{},              // 0000000000000000000000000000000000000000000000000000000000000000
+		MerkleRoot: genesisMerkleRoot,        // e0028eb9648db56b1ac77cf090b99048a8007e2bb64b68f092c03c7f56a662c7
+		Timestamp:  time.Unix(1390666206, 0), // 2014-01-25 16:10:06 +0000 UTC
+		Bits:       0x1e0ffff0,
+		Nonce:      3861367235,
+	},
+	Transactions: []*util.MsgTx{&genesisCoinbaseTx},
+}
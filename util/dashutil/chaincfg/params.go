@@ -0,0 +1,132 @@
+// Copyright (c) 2014-2016 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/suyhuai/addressutil/util"
+)
+
+const (
+	// MainNet represents the main dash network.
+	MainNet util.BitcoinNet = 0xbd6b0cbf
+
+	// TestNet3 represents the test network (version 3).
+	TestNet3 util.BitcoinNet = 0xffcae2ce
+)
+
+// bnStrings is a map of dash networks back to their constant names for
+// pretty printing.
+var bnStrings = map[util.BitcoinNet]string{
+	MainNet:  "MainNet",
+	TestNet3: "TestNet3",
+}
+
+// These variables are the chain proof-of-work limit parameters for each default
+// network.
+var (
+	// bigOne is 1 represented as a big.Int.  It is defined here to avoid
+	// the overhead of creating it multiple times.
+	bigOne = big.NewInt(1)
+
+	// mainPowLimit is the highest proof of work value a Dash block can
+	// have for the main network.  It is the value 2^236 - 1.
+	mainPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 236), bigOne)
+
+	// testNet3PowLimit is the highest proof of work value a Dash block
+	// can have for the test network (version 3).  It is the value
+	// 2^236 - 1.
+	testNet3PowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 236), bigOne)
+)
+
+// MainNetParams defines the network parameters for the main Dash network.
+var MainNetParams = util.Params{
+	Name:        "mainnet",
+	Net:         MainNet,
+	DefaultPort: "9999",
+	DNSSeeds: []util.DNSSeed{
+		{"dnsseed.dash.org", false},
+		{"dnsseed.dashdot.io", false},
+	},
+
+	// Chain parameters
+	GenesisBlock:             &mainGenesisBlock,
+	GenesisHash:              &mainGenesisHash,
+	PowLimit:                 mainPowLimit,
+	PowLimitBits:             0x1e0fffff,
+	CoinbaseMaturity:         100,
+	SubsidyReductionInterval: 210240,
+	TargetTimespan:           time.Hour * 24,                         // 1 day
+	TargetTimePerBlock:       (time.Minute * 2) + (time.Second * 30), // 2.5 minutes
+	RetargetAdjustmentFactor: 4,                                      // 25% less, 400% more
+	ReduceMinDifficulty:      false,
+	MinDiffReductionTime:     0,
+	GenerateSupported:        false,
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints: nil,
+
+	// Mempool parameters
+	RelayNonStdTxs: false,
+
+	// Address encoding magics
+	PubKeyHashAddrID: 0x4c, // starts with X
+	ScriptHashAddrID: 0x10, // starts with 7
+	PrivateKeyID:     0xcc, // starts with 7 (uncompressed) or X (compressed)
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x02, 0xfe, 0x52, 0xf8}, // starts with drkv
+	HDPublicKeyID:  [4]byte{0x02, 0xfe, 0x52, 0xcc}, // starts with drkp
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation.
+	HDCoinType: 5,
+}
+
+// TestNet3Params defines the network parameters for the test Dash network
+// (version 3).
+var TestNet3Params = util.Params{
+	Name:        "testnet3",
+	Net:         TestNet3,
+	DefaultPort: "19999",
+	DNSSeeds: []util.DNSSeed{
+		{"testnet-seed.dashdot.io", false},
+	},
+
+	// Chain parameters
+	GenesisBlock:             &testNetGenesisBlock,
+	GenesisHash:              &testNetGenesisHash,
+	PowLimit:                 testNet3PowLimit,
+	PowLimitBits:             0x1e0fffff,
+	CoinbaseMaturity:         15,
+	SubsidyReductionInterval: 210240,
+	TargetTimespan:           time.Hour * 24,                         // 1 day
+	TargetTimePerBlock:       (time.Minute * 2) + (time.Second * 30), // 2.5 minutes
+	RetargetAdjustmentFactor: 4,                                      // 25% less, 400% more
+	ReduceMinDifficulty:      true,
+	MinDiffReductionTime:     time.Minute * 5, // TargetTimePerBlock * 2
+	GenerateSupported:        false,
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints: nil,
+
+	// Mempool parameters
+	RelayNonStdTxs: true,
+
+	// Address encoding magics
+	PubKeyHashAddrID: 0x8c, // starts with y
+	ScriptHashAddrID: 0x13, // starts with 8 or 9
+	PrivateKeyID:     0xef, // starts with 9 (uncompressed) or c (compressed)
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x3a, 0x80, 0x61, 0xa0}, // starts with DRKV
+	HDPublicKeyID:  [4]byte{0x3a, 0x80, 0x58, 0x37}, // starts with DRKP
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation.
+	HDCoinType: 1,
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2014-2016 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/suyhuai/addressutil/util"
+)
+
+const (
+	// MainNet represents the main dogecoin network.
+	MainNet util.BitcoinNet = 0xc0c0c0c0
+
+	// TestNet3 represents the test network (version 3).
+	TestNet3 util.BitcoinNet = 0xdcb7c1fc
+)
+
+// bnStrings is a map of dogecoin networks back to their constant names for
+// pretty printing.
+var bnStrings = map[util.BitcoinNet]string{
+	MainNet:  "MainNet",
+	TestNet3: "TestNet3",
+}
+
+// These variables are the chain proof-of-work limit parameters for each default
+// network.
+var (
+	// bigOne is 1 represented as a big.Int.  It is defined here to avoid
+	// the overhead of creating it multiple times.
+	bigOne = big.NewInt(1)
+
+	// mainPowLimit is the highest proof of work value a Dogecoin block can
+	// have for the main network.  It is the value 2^236 - 1.
+	mainPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 236), bigOne)
+
+	// testNet3PowLimit is the highest proof of work value a Dogecoin block
+	// can have for the test network (version 3).  It is the value
+	// 2^236 - 1.
+	testNet3PowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 236), bigOne)
+)
+
+// MainNetParams defines the network parameters for the main Dogecoin network.
+var MainNetParams = util.Params{
+	Name:        "mainnet",
+	Net:         MainNet,
+	DefaultPort: "22556",
+	DNSSeeds: []util.DNSSeed{
+		{"seed.multidoge.org", false},
+		{"seed2.multidoge.org", false},
+	},
+
+	// Chain parameters
+	GenesisBlock:             &mainGenesisBlock,
+	GenesisHash:              &mainGenesisHash,
+	PowLimit:                 mainPowLimit,
+	PowLimitBits:             0x1e0fffff,
+	CoinbaseMaturity:         240,
+	SubsidyReductionInterval: 100000,
+	TargetTimespan:           time.Minute, // 1 minute
+	TargetTimePerBlock:       time.Minute, // 1 minute
+	RetargetAdjustmentFactor: 4,           // 25% less, 400% more
+	ReduceMinDifficulty:      false,
+	MinDiffReductionTime:     0,
+	GenerateSupported:        false,
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints: nil,
+
+	// Mempool parameters
+	RelayNonStdTxs: false,
+
+	// Address encoding magics
+	PubKeyHashAddrID: 0x1e, // starts with D
+	ScriptHashAddrID: 0x16, // starts with 9 or A
+	PrivateKeyID:     0x9e, // starts with 6 (uncompressed) or Q (compressed)
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x02, 0xfa, 0xc3, 0x98}, // starts with dgpv
+	HDPublicKeyID:  [4]byte{0x02, 0xfa, 0xca, 0xfd}, // starts with dgub
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation.
+	HDCoinType: 3,
+}
+
+// TestNet3Params defines the network parameters for the test Dogecoin network
+// (version 3).
+var TestNet3Params = util.Params{
+	Name:        "testnet3",
+	Net:         TestNet3,
+	DefaultPort: "44556",
+	DNSSeeds: []util.DNSSeed{
+		{"testseed.jrn.me.uk", false},
+	},
+
+	// Chain parameters
+	GenesisBlock:             &testNetGenesisBlock,
+	GenesisHash:              &testNetGenesisHash,
+	PowLimit:                 testNet3PowLimit,
+	PowLimitBits:             0x1e0fffff,
+	CoinbaseMaturity:         30,
+	SubsidyReductionInterval: 100000,
+	TargetTimespan:           time.Minute, // 1 minute
+	TargetTimePerBlock:       time.Minute, // 1 minute
+	RetargetAdjustmentFactor: 4,           // 25% less, 400% more
+	ReduceMinDifficulty:      true,
+	MinDiffReductionTime:     time.Minute * 2, // TargetTimePerBlock * 2
+	GenerateSupported:        false,
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints: nil,
+
+	// Mempool parameters
+	RelayNonStdTxs: true,
+
+	// Address encoding magics
+	PubKeyHashAddrID: 0x71, // starts with n
+	ScriptHashAddrID: 0xc4, // starts with 2
+	PrivateKeyID:     0xf1, // starts with 9 (uncompressed) or c (compressed)
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // starts with tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // starts with tpub
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation.
+	HDCoinType: 1,
+}
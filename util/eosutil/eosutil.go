@@ -1,20 +1,86 @@
 package eosutil
 
 import (
+	"errors"
 	"strings"
 )
 
 const AccountChars = ".12345abcdefghijklmnopqrstuvwxyz"
 
+// LastCharChars are the characters allowed in the 13th position of a name,
+// which only has 4 bits left in the encoded uint64.
+const LastCharChars = ".12345abcdefghij"
+
+// MaxNameLen is the maximum length of a name.  Accounts created through
+// eosio.system are limited to 12 characters, the 13th is only reachable by
+// names used for tables, actions and the like.
+const MaxNameLen = 13
+
+var ErrNameFormat = errors.New("invalid EOS name")
+
+// CheckEOSAccount reports whether s is a valid name: 1 to 13 characters from
+// AccountChars, the 13th restricted to LastCharChars, and not ending in a dot.
 func CheckEOSAccount(s string) bool {
-	if len(s) > 12 {
+	if len(s) == 0 || len(s) > MaxNameLen {
 		return false
 	}
-	chars := []rune(s)
-	for _, c := range chars {
-		if !strings.ContainsRune(AccountChars, c) {
+	for i := 0; i < len(s); i++ {
+		chars := AccountChars
+		if i == MaxNameLen-1 {
+			chars = LastCharChars
+		}
+		if strings.IndexByte(chars, s[i]) < 0 {
 			return false
 		}
 	}
-	return true
+	return s[len(s)-1] != '.'
+}
+
+// NameToUint64 encodes a name into its uint64 representation as used in
+// actions and tables.  Each of the first 12 characters takes 5 bits starting
+// from the most significant end, the 13th takes the remaining 4.
+func NameToUint64(s string) (uint64, error) {
+	if !CheckEOSAccount(s) {
+		return 0, ErrNameFormat
+	}
+
+	var n uint64
+	for i := 0; i < len(s); i++ {
+		c := uint64(strings.IndexByte(AccountChars, s[i]))
+		if i < MaxNameLen-1 {
+			n |= (c & 0x1f) << uint(64-5*(i+1))
+		} else {
+			n |= c & 0x0f
+		}
+	}
+	return n, nil
+}
+
+// Uint64ToName decodes the uint64 representation of a name.
+func Uint64ToName(n uint64) string {
+	var name [MaxNameLen]byte
+	for i := 0; i < MaxNameLen; i++ {
+		if i == 0 {
+			name[MaxNameLen-1] = AccountChars[n&0x0f]
+			n >>= 4
+		} else {
+			name[MaxNameLen-1-i] = AccountChars[n&0x1f]
+			n >>= 5
+		}
+	}
+	return strings.TrimRight(string(name[:]), ".")
+}
+
+// NameSuffix returns the part of name after its last dot, or name itself when
+// it has no dot.  Only the owner of the suffix account may create dotted
+// names, e.g. "bob.eos" can only be created by "eos".
+func NameSuffix(name string) string {
+	return name[strings.LastIndexByte(name, '.')+1:]
+}
+
+// IsPremiumName reports whether name is a premium name, i.e. a name shorter
+// than 12 characters without a dot.  Premium names are sold through the name
+// auction instead of being created freely.
+func IsPremiumName(name string) bool {
+	return CheckEOSAccount(name) && len(name) < MaxNameLen-1 && !strings.Contains(name, ".")
 }
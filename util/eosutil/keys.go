@@ -0,0 +1,159 @@
+package eosutil
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/suyhuai/addressutil/base58"
+	"github.com/suyhuai/addressutil/ecc"
+	"github.com/suyhuai/addressutil/ripemd160"
+)
+
+// String prefixes of the key and signature formats.  Legacy public keys are
+// checksummed over the key alone, the K1 forms append the curve name "K1" to
+// the checksummed data.
+const (
+	LegacyPublicKeyPrefix = "EOS"
+	PublicKeyPrefix       = "PUB_K1_"
+	PrivateKeyPrefix      = "PVT_K1_"
+	SignaturePrefix       = "SIG_K1_"
+
+	k1Suffix = "K1"
+
+	// compactSigLen is the length of a recoverable signature: one header
+	// byte followed by R and S.
+	compactSigLen = 65
+)
+
+var (
+	ErrKeyFormat        = errors.New("invalid EOS key format")
+	ErrSignatureFormat  = errors.New("invalid EOS signature format")
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+)
+
+// PublicKeyToString returns the legacy "EOS…" form of pub.
+func PublicKeyToString(pub *ecc.PublicKey) string {
+	return LegacyPublicKeyPrefix + encodeChecksummed(pub.SerializeCompressed(), "")
+}
+
+// PublicKeyToK1String returns the "PUB_K1_…" form of pub.
+func PublicKeyToK1String(pub *ecc.PublicKey) string {
+	return PublicKeyPrefix + encodeChecksummed(pub.SerializeCompressed(), k1Suffix)
+}
+
+// ParsePublicKey parses a public key in either the legacy "EOS…" or the
+// "PUB_K1_…" form.
+func ParsePublicKey(s string) (*ecc.PublicKey, error) {
+	var payload []byte
+	var err error
+	switch {
+	case strings.HasPrefix(s, PublicKeyPrefix):
+		payload, err = decodeChecksummed(s[len(PublicKeyPrefix):], k1Suffix)
+	case strings.HasPrefix(s, LegacyPublicKeyPrefix):
+		payload, err = decodeChecksummed(s[len(LegacyPublicKeyPrefix):], "")
+	default:
+		return nil, ErrKeyFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := ecc.ParsePubKey(payload, ecc.S256())
+	if err != nil {
+		return nil, ErrKeyFormat
+	}
+	return pub, nil
+}
+
+// PrivateKeyToString returns the "PVT_K1_…" form of priv.
+func PrivateKeyToString(priv *ecc.PrivateKey) string {
+	return PrivateKeyPrefix + encodeChecksummed(priv.Serialize(), k1Suffix)
+}
+
+// ParsePrivateKey parses a private key in the "PVT_K1_…" form.
+func ParsePrivateKey(s string) (*ecc.PrivateKey, error) {
+	if !strings.HasPrefix(s, PrivateKeyPrefix) {
+		return nil, ErrKeyFormat
+	}
+	payload, err := decodeChecksummed(s[len(PrivateKeyPrefix):], k1Suffix)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) != ecc.PrivKeyBytesLen {
+		return nil, ErrKeyFormat
+	}
+
+	priv, _ := ecc.PrivKeyFromBytes(ecc.S256(), payload)
+	return priv, nil
+}
+
+// SignatureToString returns the "SIG_K1_…" form of sig.  recoveryID is the
+// public key recovery index (0-3) produced alongside the signature.
+func SignatureToString(sig *ecc.Signature, recoveryID byte) string {
+	payload := make([]byte, 1, compactSigLen)
+	// 27 marks a recoverable signature, 4 a compressed public key.
+	payload[0] = 27 + 4 + recoveryID&3
+	payload = paddedAppend(32, payload, sig.R.Bytes())
+	payload = paddedAppend(32, payload, sig.S.Bytes())
+	return SignaturePrefix + encodeChecksummed(payload, k1Suffix)
+}
+
+// ParseSignature parses a "SIG_K1_…" signature and returns it together with
+// its public key recovery index.
+func ParseSignature(s string) (*ecc.Signature, byte, error) {
+	if !strings.HasPrefix(s, SignaturePrefix) {
+		return nil, 0, ErrSignatureFormat
+	}
+	payload, err := decodeChecksummed(s[len(SignaturePrefix):], k1Suffix)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(payload) != compactSigLen || payload[0] < 27 || payload[0] > 34 {
+		return nil, 0, ErrSignatureFormat
+	}
+
+	sig := &ecc.Signature{
+		R: new(big.Int).SetBytes(payload[1:33]),
+		S: new(big.Int).SetBytes(payload[33:]),
+	}
+	return sig, (payload[0] - 27) & 3, nil
+}
+
+// encodeChecksummed base58 encodes payload followed by the first four bytes
+// of ripemd160(payload || suffix).
+func encodeChecksummed(payload []byte, suffix string) string {
+	b := make([]byte, 0, len(payload)+4)
+	b = append(b, payload...)
+	b = append(b, checksum(payload, suffix)...)
+	return base58.Encode(b)
+}
+
+// decodeChecksummed reverses encodeChecksummed.
+func decodeChecksummed(s, suffix string) ([]byte, error) {
+	decoded := base58.Decode(s)
+	if len(decoded) < 5 {
+		return nil, ErrKeyFormat
+	}
+	payload := decoded[:len(decoded)-4]
+	if !bytes.Equal(decoded[len(decoded)-4:], checksum(payload, suffix)) {
+		return nil, ErrChecksumMismatch
+	}
+	return payload, nil
+}
+
+func checksum(payload []byte, suffix string) []byte {
+	hasher := ripemd160.New()
+	hasher.Write(payload)
+	hasher.Write([]byte(suffix))
+	return hasher.Sum(nil)[:4]
+}
+
+// paddedAppend appends src to dst, left padding it with zeros to size bytes.
+func paddedAppend(size int, dst, src []byte) []byte {
+	for i := 0; i < size-len(src); i++ {
+		dst = append(dst, 0)
+	}
+	return append(dst, src...)
+}
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"math/rand"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/suyhuai/addressutil/hexutil"
@@ -179,9 +180,20 @@ func (a Address) Hash() Hash { return BytesToHash(a[:]) }
 
 // Hex returns an EIP55-compliant hex string representation of the address.
 func (a Address) Hex() string {
+	return a.ChecksumHex(0)
+}
+
+// ChecksumHex returns the mixed-case hex string representation of the address
+// for the given chain.  A zero chainID yields the plain EIP55 checksum, any
+// other value mixes the chain ID into the hashed input as defined by EIP1191.
+func (a Address) ChecksumHex(chainID uint64) string {
 	unchecksummed := hex.EncodeToString(a[:])
+	input := unchecksummed
+	if chainID != 0 {
+		input = strconv.FormatUint(chainID, 10) + "0x" + unchecksummed
+	}
 	sha := sha3.NewLegacyKeccak256()
-	sha.Write([]byte(unchecksummed))
+	sha.Write([]byte(input))
 	hash := sha.Sum(nil)
 
 	result := []byte(unchecksummed)
@@ -320,6 +332,20 @@ func (ma *MixedcaseAddress) ValidChecksum() bool {
 	return ma.original == ma.addr.Hex()
 }
 
+// ValidChainChecksum returns true if the address has a valid checksum for the
+// given chain, see Address.ChecksumHex.  Addresses written entirely in lower
+// or upper case carry no checksum and are always accepted.
+func (ma *MixedcaseAddress) ValidChainChecksum(chainID uint64) bool {
+	original := ma.original
+	if hasHexPrefix(original) {
+		original = original[2:]
+	}
+	if original == strings.ToLower(original) || original == strings.ToUpper(original) {
+		return true
+	}
+	return original == ma.addr.ChecksumHex(chainID)[2:]
+}
+
 // Original returns the mixed-case input string
 func (ma *MixedcaseAddress) Original() string {
 	return ma.original
@@ -0,0 +1,132 @@
+package ethutil
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ICAP (Inter exchange Client Address Protocol) wraps an Ethereum address into
+// an IBAN compatible string with the "XE" country code and ISO13616 mod-97
+// check digits.  Direct ICAPs hold the address in 30 base36 characters and can
+// only express addresses below 2^155, Basic ICAPs use 31 characters and can
+// express any address.
+//
+// https://github.com/ethereum/wiki/wiki/Inter-exchange-Client-Address-Protocol-(ICAP)
+
+const (
+	icapCountryCode  = "XE"
+	icapDirectLength = 34
+	icapBasicLength  = 35
+)
+
+var (
+	ErrICAPLength      = errors.New("invalid ICAP length")
+	ErrICAPEncoding    = errors.New("invalid ICAP encoding")
+	ErrICAPChecksum    = errors.New("invalid ICAP checksum")
+	ErrICAPCountryCode = errors.New("invalid ICAP country code")
+	ErrICAPIndirect    = errors.New("indirect ICAP is not supported")
+
+	big97 = big.NewInt(97)
+
+	// icapDirectLimit is the exclusive upper bound of addresses the Direct
+	// form can express.
+	icapDirectLimit = new(big.Int).Lsh(big.NewInt(1), 155)
+)
+
+// ICAPToAddress decodes a Direct or Basic ICAP string into an Address.
+func ICAPToAddress(s string) (Address, error) {
+	s = strings.ToUpper(s)
+	switch len(s) {
+	case icapDirectLength, icapBasicLength:
+	case 20:
+		// "XE" + checksum + asset + institution + client identifiers
+		return Address{}, ErrICAPIndirect
+	default:
+		return Address{}, ErrICAPLength
+	}
+
+	if !strings.HasPrefix(s, icapCountryCode) {
+		return Address{}, ErrICAPCountryCode
+	}
+	if !isDigit(s[2]) || !isDigit(s[3]) {
+		return Address{}, ErrICAPEncoding
+	}
+	if err := validICAPChecksum(s); err != nil {
+		return Address{}, err
+	}
+
+	bigAddr, ok := new(big.Int).SetString(s[4:], 36)
+	if !ok || bigAddr.BitLen() > 8*AddressLength {
+		return Address{}, ErrICAPEncoding
+	}
+	if len(s) == icapDirectLength && bigAddr.Cmp(icapDirectLimit) >= 0 {
+		return Address{}, ErrICAPEncoding
+	}
+	return BigToAddress(bigAddr), nil
+}
+
+// AddressToICAP encodes a as a Direct ICAP.  Addresses of 2^155 and above
+// return ErrICAPEncoding, use AddressToBasicICAP for those.
+func AddressToICAP(a Address) (string, error) {
+	bigAddr := a.Big()
+	if bigAddr.Cmp(icapDirectLimit) >= 0 {
+		return "", ErrICAPEncoding
+	}
+	return encodeICAP(strings.ToUpper(bigAddr.Text(36)), icapDirectLength-4), nil
+}
+
+// AddressToBasicICAP encodes a as a Basic ICAP.
+func AddressToBasicICAP(a Address) string {
+	return encodeICAP(strings.ToUpper(a.Big().Text(36)), icapBasicLength-4)
+}
+
+// encodeICAP zero pads the base36 encoded address to size characters and
+// prepends the country code and check digits.
+func encodeICAP(enc string, size int) string {
+	if len(enc) < size {
+		enc = strings.Repeat("0", size-len(enc)) + enc
+	}
+	return icapCountryCode + icapCheckDigits(enc) + enc
+}
+
+// icapCheckDigits computes the ISO13616 check digits for the BBAN part.
+func icapCheckDigits(bban string) string {
+	mod, _ := icapMod97(bban + icapCountryCode + "00")
+	return fmt.Sprintf("%02d", 98-mod)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func validICAPChecksum(s string) error {
+	mod, err := icapMod97(s[4:] + s[:4])
+	if err != nil {
+		return err
+	}
+	if mod != 1 {
+		return ErrICAPChecksum
+	}
+	return nil
+}
+
+// icapMod97 replaces every letter of s by its two digit value (A = 10, ...,
+// Z = 35) and returns the resulting decimal number modulo 97.
+func icapMod97(s string) (int, error) {
+	var digits strings.Builder
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			digits.WriteRune(c)
+		case c >= 'A' && c <= 'Z':
+			digits.WriteString(strconv.Itoa(int(c-'A') + 10))
+		default:
+			return 0, ErrICAPEncoding
+		}
+	}
+	n, _ := new(big.Int).SetString(digits.String(), 10)
+	return int(new(big.Int).Mod(n, big97).Int64()), nil
+}
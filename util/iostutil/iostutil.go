@@ -0,0 +1,78 @@
+package iostutil
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"regexp"
+
+	"github.com/suyhuai/addressutil/base58"
+	"github.com/suyhuai/addressutil/ecc"
+)
+
+// Algorithm identifies the signature algorithm of an IOST key.
+type Algorithm int
+
+const (
+	Secp256k1 Algorithm = iota + 1
+	Ed25519
+)
+
+func (a Algorithm) String() string {
+	switch a {
+	case Secp256k1:
+		return "secp256k1"
+	case Ed25519:
+		return "ed25519"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	ErrPublicKeyFormat = errors.New("invalid IOST public key")
+
+	accountRegexp = regexp.MustCompile(`^[a-z0-9_]{5,11}$`)
+)
+
+// CheckAccount reports whether name is a valid IOST account name: 5 to 11
+// characters of lower case letters, digits and underscores.
+func CheckAccount(name string) bool {
+	return accountRegexp.MatchString(name)
+}
+
+// EncodePublicKey returns the base58 form of pub used to bind IOST account
+// permissions.  secp256k1 keys are encoded compressed, ed25519 keys raw.
+func EncodePublicKey(pub []byte, algo Algorithm) (string, error) {
+	switch algo {
+	case Secp256k1:
+		key, err := ecc.ParsePubKey(pub, ecc.S256())
+		if err != nil {
+			return "", ErrPublicKeyFormat
+		}
+		return base58.Encode(key.SerializeCompressed()), nil
+	case Ed25519:
+		if len(pub) != ed25519.PublicKeySize {
+			return "", ErrPublicKeyFormat
+		}
+		return base58.Encode(pub), nil
+	default:
+		return "", ErrPublicKeyFormat
+	}
+}
+
+// DecodePublicKey parses a base58 encoded public key, telling the algorithm
+// apart by the key length.
+func DecodePublicKey(s string) ([]byte, Algorithm, error) {
+	pub := base58.Decode(s)
+	switch len(pub) {
+	case ecc.PubKeyBytesLenCompressed:
+		if _, err := ecc.ParsePubKey(pub, ecc.S256()); err != nil {
+			return nil, 0, ErrPublicKeyFormat
+		}
+		return pub, Secp256k1, nil
+	case ed25519.PublicKeySize:
+		return pub, Ed25519, nil
+	default:
+		return nil, 0, ErrPublicKeyFormat
+	}
+}
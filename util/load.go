@@ -0,0 +1,153 @@
+package util
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrParamsFormat = errors.New("invalid network params")
+
+// paramsConfig is the file format read by LoadParams.  It only covers the
+// address related parts of Params.
+type paramsConfig struct {
+	Chain       string `yaml:"chain"`
+	Name        string `yaml:"name"`
+	Net         uint32 `yaml:"net"`
+	DefaultPort string `yaml:"defaultPort"`
+
+	Bech32HRPSegwit string `yaml:"bech32HRPSegwit"`
+
+	PubKeyHashAddrID        *byte `yaml:"pubKeyHashAddrID"`
+	ScriptHashAddrID        *byte `yaml:"scriptHashAddrID"`
+	WitnessPubKeyHashAddrID byte  `yaml:"witnessPubKeyHashAddrID"`
+	WitnessScriptHashAddrID byte  `yaml:"witnessScriptHashAddrID"`
+
+	PubKeyHashAddrPrefix string `yaml:"pubKeyHashAddrPrefix"`
+	ScriptHashAddrPrefix string `yaml:"scriptHashAddrPrefix"`
+
+	CashAddressPrefix      string `yaml:"cashAddressPrefix"`
+	LegacyPubKeyHashAddrID byte   `yaml:"legacyPubKeyHashAddrID"`
+	LegacyScriptHashAddrID byte   `yaml:"legacyScriptHashAddrID"`
+
+	PrivateKeyID byte `yaml:"privateKeyID"`
+
+	HDPrivateKeyID string `yaml:"hdPrivateKeyID"`
+	HDPublicKeyID  string `yaml:"hdPublicKeyID"`
+	HDCoinType     uint32 `yaml:"hdCoinType"`
+}
+
+// LoadParams reads network params from a JSON or YAML document and registers
+// them through Register.  Keys are named after the Params fields, e.g.
+//
+//	chain: BTG
+//	name: mainnet
+//	bech32HRPSegwit: btg
+//	pubKeyHashAddrID: 38
+//	scriptHashAddrID: 23
+//	privateKeyID: 128
+//	hdPrivateKeyID: 0488ade4
+//	hdPublicKeyID: 0488b21e
+//	hdCoinType: 156
+//
+// chain and name are required, name being one of the network names used by the
+// root package ("mainnet", "testnet", "regtest", ...), and so is one of
+// pubKeyHashAddrID, pubKeyHashAddrPrefix or cashAddressPrefix.  A P2PKH
+// version needs a distinct P2SH version next to it: scriptHashAddrID for
+// pubKeyHashAddrID, scriptHashAddrPrefix for pubKeyHashAddrPrefix.  The
+// multi-byte prefixes are hex encoded.  Unknown keys are rejected, and so are
+// chains the root package handles itself (ErrDuplicateChain).
+func LoadParams(r io.Reader) (*Params, error) {
+	var c paramsConfig
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&c); err != nil {
+		return nil, err
+	}
+	if c.Chain == "" || !IsNetworkName(c.Name) {
+		return nil, ErrParamsFormat
+	}
+	if c.PubKeyHashAddrID == nil && c.PubKeyHashAddrPrefix == "" && c.CashAddressPrefix == "" {
+		return nil, ErrParamsFormat
+	}
+	if c.PubKeyHashAddrID != nil {
+		if c.ScriptHashAddrID == nil || *c.ScriptHashAddrID == *c.PubKeyHashAddrID {
+			return nil, ErrParamsFormat
+		}
+	}
+
+	params := &Params{
+		Chain:       c.Chain,
+		Name:        c.Name,
+		Net:         BitcoinNet(c.Net),
+		DefaultPort: c.DefaultPort,
+
+		Bech32HRPSegwit: c.Bech32HRPSegwit,
+
+		WitnessPubKeyHashAddrID: c.WitnessPubKeyHashAddrID,
+		WitnessScriptHashAddrID: c.WitnessScriptHashAddrID,
+
+		CashAddressPrefix:      c.CashAddressPrefix,
+		LegacyPubKeyHashAddrID: c.LegacyPubKeyHashAddrID,
+		LegacyScriptHashAddrID: c.LegacyScriptHashAddrID,
+
+		PrivateKeyID: c.PrivateKeyID,
+		HDCoinType:   c.HDCoinType,
+	}
+	if c.PubKeyHashAddrID != nil {
+		params.PubKeyHashAddrID = *c.PubKeyHashAddrID
+		params.ScriptHashAddrID = *c.ScriptHashAddrID
+	}
+	if err := decodeAddrPrefix(c.PubKeyHashAddrPrefix, &params.PubKeyHashAddrPrefix); err != nil {
+		return nil, err
+	}
+	if err := decodeAddrPrefix(c.ScriptHashAddrPrefix, &params.ScriptHashAddrPrefix); err != nil {
+		return nil, err
+	}
+	if len(params.PubKeyHashAddrPrefix) != 0 &&
+		(len(params.ScriptHashAddrPrefix) == 0 || bytes.Equal(params.PubKeyHashAddrPrefix, params.ScriptHashAddrPrefix)) {
+		return nil, ErrParamsFormat
+	}
+	if err := decodeHDKeyID(c.HDPrivateKeyID, &params.HDPrivateKeyID); err != nil {
+		return nil, err
+	}
+	if err := decodeHDKeyID(c.HDPublicKeyID, &params.HDPublicKeyID); err != nil {
+		return nil, err
+	}
+
+	if err := Register(params); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// decodeHDKeyID decodes a 4 byte hex encoded extended key version.  An empty
+// string leaves id untouched.
+func decodeHDKeyID(s string, id *[4]byte) error {
+	if s == "" {
+		return nil
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != len(id) {
+		return ErrParamsFormat
+	}
+	copy(id[:], b)
+	return nil
+}
+
+// decodeAddrPrefix decodes a hex encoded base58 version prefix.  An empty
+// string leaves prefix untouched.
+func decodeAddrPrefix(s string, prefix *[]byte) error {
+	if s == "" {
+		return nil
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return ErrParamsFormat
+	}
+	*prefix = b
+	return nil
+}
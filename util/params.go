@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -33,33 +34,105 @@ var (
 )
 
 var (
-	registeredNets       = make(map[BitcoinNet]struct{})
-	pubKeyHashAddrIDs    = make(map[byte]struct{})
-	scriptHashAddrIDs    = make(map[byte]struct{})
-	cashAddressPrefixes  = make(map[string]struct{})
-	hdPrivToPubKeyIDs    = make(map[[4]byte][]byte)
-	bech32SegwitPrefixes = make(map[string]struct{})
+	ErrDuplicateNet   = errors.New("duplicate Bitcoin network")
+	ErrDuplicateChain = errors.New("duplicate chain")
 )
 
-var (
-	ErrDuplicateNet = errors.New("duplicate Bitcoin network")
-)
+// registryMu guards registeredParams and reservedChains, which Register and
+// ReserveChain may change while addresses are being looked up.
+var registryMu sync.RWMutex
+
+// registeredParams holds the params added through Register, keyed by chain and
+// network name.
+var registeredParams = make(map[string]*Params)
+
+// reservedChains holds the chains whose addresses are handled by code rather
+// than by registered params, see ReserveChain.
+var reservedChains = make(map[string]struct{})
+
+// networkNames are the names of the networks of the root package, which
+// looks registered params up by them.
+var networkNames = map[string]struct{}{
+	"mainnet":  {},
+	"testnet":  {},
+	"regtest":  {},
+	"signet":   {},
+	"testnet4": {},
+	"chipnet":  {},
+	"simnet":   {},
+}
 
-func IsPubKeyHashAddrID(id byte) bool {
-	_, ok := pubKeyHashAddrIDs[id]
+// IsNetworkName reports whether name is the name of a network of the root
+// package, and so can be used as the Name of registered params.
+func IsNetworkName(name string) bool {
+	_, ok := networkNames[strings.ToLower(name)]
 	return ok
 }
-func IsScriptHashAddrID(id byte) bool {
-	_, ok := scriptHashAddrIDs[id]
-	return ok
+
+func paramsKey(chain, name string) string {
+	return strings.ToUpper(chain) + "/" + strings.ToLower(name)
+}
+
+// ReserveChain marks chain as handled by code other than registered params,
+// so that Register refuses params for it.  It returns ErrDuplicateChain when
+// chain is already reserved or has registered params.
+func ReserveChain(chain string) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	chain = strings.ToUpper(chain)
+	if _, ok := reservedChains[chain]; ok {
+		return ErrDuplicateChain
+	}
+	for key := range registeredParams {
+		if strings.HasPrefix(key, chain+"/") {
+			return ErrDuplicateChain
+		}
+	}
+	reservedChains[chain] = struct{}{}
+	return nil
 }
 
-func IsBech32SegwitPrefix(prefix string) bool {
-	prefix = strings.ToLower(prefix)
-	_, ok := bech32SegwitPrefixes[prefix]
+// IsReservedChain reports whether chain was reserved through ReserveChain.
+func IsReservedChain(chain string) bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	_, ok := reservedChains[strings.ToUpper(chain)]
 	return ok
 }
 
+// Register makes params available to LookupParams.  Params whose Name is not a
+// network name (see IsNetworkName) return ErrParamsFormat, params for a
+// reserved chain ErrDuplicateChain and params for a network of the chain that
+// is already registered ErrDuplicateNet.
+func Register(params *Params) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if !IsNetworkName(params.Name) {
+		return ErrParamsFormat
+	}
+	if _, ok := reservedChains[strings.ToUpper(params.Chain)]; ok {
+		return ErrDuplicateChain
+	}
+	key := paramsKey(params.Chain, params.Name)
+	if _, ok := registeredParams[key]; ok {
+		return ErrDuplicateNet
+	}
+	registeredParams[key] = params
+	return nil
+}
+
+// LookupParams returns the params registered for the network name of chain.
+func LookupParams(chain, name string) (*Params, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	params, ok := registeredParams[paramsKey(chain, name)]
+	return params, ok
+}
+
 type DNSSeed struct {
 	Host         string
 	HasFiltering bool
@@ -114,6 +187,10 @@ type TxOut struct {
 }
 
 type Params struct {
+	// Chain is the ticker of the chain the params belong to.  It is only
+	// needed for params added at runtime through Register.
+	Chain string
+
 	Name         string
 	Net          BitcoinNet
 	DefaultPort  string
@@ -154,6 +231,10 @@ type Params struct {
 	ScriptHashAddrID        byte // First byte of a P2SH address
 	WitnessPubKeyHashAddrID byte // First byte of a P2WPKH address
 	WitnessScriptHashAddrID byte // First byte of a P2WSH address
+	// Multi-byte version prefixes of P2PKH and P2SH addresses, used instead
+	// of PubKeyHashAddrID and ScriptHashAddrID when set (Zcash, Vollar).
+	PubKeyHashAddrPrefix []byte
+	ScriptHashAddrPrefix []byte
 	// The prefix used for the cashaddress. This is different for each network.
 	CashAddressPrefix string
 
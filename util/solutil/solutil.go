@@ -0,0 +1,128 @@
+package solutil
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"github.com/suyhuai/addressutil/base58"
+	"github.com/suyhuai/addressutil/ecc"
+)
+
+const (
+	// PublicKeyLength is the length of a Solana address in bytes.
+	PublicKeyLength = 32
+
+	// MaxSeeds is the maximum number of seeds of a program derived address.
+	MaxSeeds = 16
+
+	// MaxSeedLength is the maximum length of a program derived address seed.
+	MaxSeedLength = 32
+)
+
+// pdaMarker is appended to the seeds of a program derived address before
+// hashing.
+const pdaMarker = "ProgramDerivedAddress"
+
+// Well known program addresses.
+const (
+	SystemProgramID          = "11111111111111111111111111111111"
+	TokenProgramID           = "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	AssociatedTokenProgramID = "ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL"
+)
+
+var (
+	ErrAddressFormat    = errors.New("invalid Solana address")
+	ErrMaxSeedsExceeded = errors.New("too many program address seeds")
+	ErrMaxSeedLength    = errors.New("program address seed too long")
+	ErrInvalidSeeds     = errors.New("program address seeds produce an on-curve point")
+	ErrNoProgramAddress = errors.New("unable to find a viable program address bump seed")
+)
+
+// EncodeAddress returns the base58 address of the 32 byte public key pub.
+func EncodeAddress(pub []byte) (string, error) {
+	if len(pub) != PublicKeyLength {
+		return "", ErrAddressFormat
+	}
+	return base58.Encode(pub), nil
+}
+
+// DecodeAddress returns the 32 byte public key of the address addr.
+func DecodeAddress(addr string) ([]byte, error) {
+	pub := base58.Decode(addr)
+	if len(pub) != PublicKeyLength {
+		return nil, ErrAddressFormat
+	}
+	return pub, nil
+}
+
+// IsOnCurve reports whether pub is an ed25519 point, which tells wallet
+// addresses apart from program derived addresses.
+func IsOnCurve(pub []byte) bool {
+	return ecc.IsOnEd25519Curve(pub)
+}
+
+// CreateProgramAddress returns the program derived address of seeds and
+// programID.  It fails with ErrInvalidSeeds if the hash lands on the curve,
+// since such an address could have a private key.
+func CreateProgramAddress(seeds [][]byte, programID []byte) ([]byte, error) {
+	if len(programID) != PublicKeyLength {
+		return nil, ErrAddressFormat
+	}
+	if len(seeds) > MaxSeeds {
+		return nil, ErrMaxSeedsExceeded
+	}
+
+	h := sha256.New()
+	for _, seed := range seeds {
+		if len(seed) > MaxSeedLength {
+			return nil, ErrMaxSeedLength
+		}
+		h.Write(seed)
+	}
+	h.Write(programID)
+	h.Write([]byte(pdaMarker))
+	addr := h.Sum(nil)
+
+	if IsOnCurve(addr) {
+		return nil, ErrInvalidSeeds
+	}
+	return addr, nil
+}
+
+// FindProgramAddress returns the first off-curve program derived address of
+// seeds and programID, searching the bump seed appended to seeds down from
+// 255, together with that bump seed.
+func FindProgramAddress(seeds [][]byte, programID []byte) ([]byte, uint8, error) {
+	if len(seeds) >= MaxSeeds {
+		return nil, 0, ErrMaxSeedsExceeded
+	}
+
+	withBump := append(append([][]byte(nil), seeds...), nil)
+	for bump := 255; bump >= 0; bump-- {
+		withBump[len(seeds)] = []byte{uint8(bump)}
+		addr, err := CreateProgramAddress(withBump, programID)
+		if err == nil {
+			return addr, uint8(bump), nil
+		}
+		if err != ErrInvalidSeeds {
+			return nil, 0, err
+		}
+	}
+	return nil, 0, ErrNoProgramAddress
+}
+
+// FindAssociatedTokenAddress returns the associated token account of wallet
+// for the token mint, owned by tokenProgramID (TokenProgramID, or the
+// program ID of another token program such as Token-2022), together with its
+// bump seed.
+func FindAssociatedTokenAddress(wallet, mint []byte, tokenProgramID string) ([]byte, uint8, error) {
+	tokenProgram, err := DecodeAddress(tokenProgramID)
+	if err != nil {
+		return nil, 0, err
+	}
+	ataProgram, _ := DecodeAddress(AssociatedTokenProgramID)
+	if len(wallet) != PublicKeyLength || len(mint) != PublicKeyLength {
+		return nil, 0, ErrAddressFormat
+	}
+	return FindProgramAddress([][]byte{wallet, tokenProgram, mint}, ataProgram)
+}
@@ -0,0 +1,119 @@
+package ss58util
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/suyhuai/addressutil/base58"
+	"github.com/suyhuai/addressutil/blake2b"
+)
+
+// MaxPrefix is the largest network prefix SS58 can encode.
+const MaxPrefix = 16383
+
+// checksumLen is the length of the checksum of 32 and 33 byte payloads.
+const checksumLen = 2
+
+// checksumPrefix is prepended to the prefixed payload before hashing.
+var checksumPrefix = []byte("SS58PRE")
+
+var (
+	ErrAddressFormat = errors.New("invalid SS58 address")
+	ErrPrefix        = errors.New("invalid SS58 network prefix")
+	ErrChecksum      = errors.New("SS58 checksum mismatch")
+)
+
+// Encode returns the SS58 address of the 32 byte account ID, or 33 byte
+// ECDSA public key, pub on the network prefix.  Prefixes below 64 take one
+// byte, larger ones two.
+func Encode(pub []byte, prefix uint16) (string, error) {
+	if len(pub) != 32 && len(pub) != 33 {
+		return "", ErrAddressFormat
+	}
+	ident, err := encodePrefix(prefix)
+	if err != nil {
+		return "", err
+	}
+
+	body := append(ident, pub...)
+	return base58.Encode(append(body, checksum(body)...)), nil
+}
+
+// Decode returns the account ID and network prefix of the SS58 address addr.
+func Decode(addr string) ([]byte, uint16, error) {
+	data := base58.Decode(addr)
+	if len(data) < 1 {
+		return nil, 0, ErrAddressFormat
+	}
+
+	prefix, n, err := decodePrefix(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if size := len(data) - n - checksumLen; size != 32 && size != 33 {
+		return nil, 0, ErrAddressFormat
+	}
+
+	body := data[:len(data)-checksumLen]
+	if !bytes.Equal(checksum(body), data[len(body):]) {
+		return nil, 0, ErrChecksum
+	}
+	return body[n:], prefix, nil
+}
+
+// ConvertPrefix re-encodes the account of addr for the network prefix, for
+// example to turn a generic Substrate address into a Polkadot address.
+func ConvertPrefix(addr string, prefix uint16) (string, error) {
+	pub, _, err := Decode(addr)
+	if err != nil {
+		return "", err
+	}
+	return Encode(pub, prefix)
+}
+
+func checksum(body []byte) []byte {
+	data := append(append([]byte{}, checksumPrefix...), body...)
+	return blake2b.Sum512(data)[:checksumLen]
+}
+
+// encodePrefix returns the serialized form of the network prefix.  The two
+// byte form packs the lower six bits of its first byte and its upper eight
+// bits behind the marker bits 01.
+func encodePrefix(prefix uint16) ([]byte, error) {
+	switch {
+	case prefix == 46 || prefix == 47 || prefix > MaxPrefix:
+		// 46 and 47 are reserved.
+		return nil, ErrPrefix
+	case prefix < 64:
+		return []byte{byte(prefix)}, nil
+	default:
+		first := byte(prefix&0xfc)>>2 | 0x40
+		second := byte(prefix>>8) | byte(prefix&0x03)<<6
+		return []byte{first, second}, nil
+	}
+}
+
+// decodePrefix returns the network prefix at the start of data and its
+// length.
+func decodePrefix(data []byte) (uint16, int, error) {
+	switch {
+	case data[0] < 64:
+		if data[0] == 46 || data[0] == 47 {
+			return 0, 0, ErrPrefix
+		}
+		return uint16(data[0]), 1, nil
+	case data[0] < 128:
+		if len(data) < 2 {
+			return 0, 0, ErrAddressFormat
+		}
+		lower := uint16(data[0]&0x3f)<<2 | uint16(data[1]>>6)
+		upper := uint16(data[1] & 0x3f)
+		prefix := lower | upper<<8
+		if prefix < 64 {
+			return 0, 0, ErrPrefix
+		}
+		return prefix, 2, nil
+	default:
+		return 0, 0, ErrPrefix
+	}
+}
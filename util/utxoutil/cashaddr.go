@@ -0,0 +1,313 @@
+package utxoutil
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/suyhuai/addressutil/util"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// AddressType is the type of a cashaddr address, encoded in its version byte.
+type AddressType int
+
+const (
+	AddrTypePayToPubKeyHash AddressType = 0
+	AddrTypePayToScriptHash AddressType = 1
+)
+
+const cashAddrCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// AddressCash is a pay-to-pubkey-hash or pay-to-script-hash address in the
+// cashaddr encoding used by Bitcoin Cash and its forks.
+type AddressCash struct {
+	prefix string
+	typ    AddressType
+	hash   [ripemd160.Size]byte
+}
+
+// NewAddressCash returns a new AddressCash of type typ for hash, which must be
+// 20 bytes, using the cashaddr prefix of net.
+func NewAddressCash(hash []byte, typ AddressType, net *util.Params) (*AddressCash, error) {
+	if net.CashAddressPrefix == "" {
+		return nil, ErrUnknownNet
+	}
+	return newAddressCash(hash, typ, net.CashAddressPrefix)
+}
+
+func newAddressCash(hash []byte, typ AddressType, prefix string) (*AddressCash, error) {
+	if len(hash) != ripemd160.Size {
+		return nil, errors.New("hash must be 20 bytes")
+	}
+	if typ != AddrTypePayToPubKeyHash && typ != AddrTypePayToScriptHash {
+		return nil, ErrUnknownAddressType
+	}
+
+	addr := &AddressCash{prefix: prefix, typ: typ}
+	copy(addr.hash[:], hash)
+	return addr, nil
+}
+
+// EncodeAddress returns the cashaddr encoding of the address including its
+// prefix.  Part of the Address interface.
+func (a *AddressCash) EncodeAddress() string {
+	// The version byte holds the type in bits 3-6 and the hash size in
+	// bits 0-2, which is always 0 for 160 bit hashes.
+	data := make([]byte, 0, 1+ripemd160.Size)
+	data = append(data, byte(a.typ)<<3)
+	data = append(data, a.hash[:]...)
+	payload, _ := convertBits(data, 8, 5, true)
+
+	var b strings.Builder
+	b.WriteString(a.prefix)
+	b.WriteByte(':')
+	for _, c := range cat(payload, createChecksum(a.prefix, payload)) {
+		b.WriteByte(cashAddrCharset[c])
+	}
+	return b.String()
+}
+
+// ScriptAddress returns the hash of the address.  Part of the Address
+// interface.
+func (a *AddressCash) ScriptAddress() []byte {
+	return a.hash[:]
+}
+
+// IsForNet returns whether or not the address is associated with the passed
+// network.  Part of the Address interface.
+func (a *AddressCash) IsForNet(net *util.Params) bool {
+	return a.prefix == net.CashAddressPrefix
+}
+
+// String returns the cashaddr encoding of the address.
+func (a *AddressCash) String() string {
+	return a.EncodeAddress()
+}
+
+// Type returns whether the address is pay-to-pubkey-hash or
+// pay-to-script-hash.
+func (a *AddressCash) Type() AddressType {
+	return a.typ
+}
+
+// Hash160 returns the underlying array of the hash.
+func (a *AddressCash) Hash160() *[ripemd160.Size]byte {
+	return &a.hash
+}
+
+// decodeCashAddress decodes a cashaddr address, prepending prefix when the
+// address has none.
+func decodeCashAddress(addr, prefix string) (*AddressCash, error) {
+	if !strings.Contains(addr, ":") {
+		addr = prefix + ":" + addr
+	}
+
+	pre, data, err := DecodeCashAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	data, err = convertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != 1+ripemd160.Size {
+		return nil, errors.New("incorrect data length")
+	}
+
+	switch data[0] {
+	case 0x00:
+		return newAddressCash(data[1:], AddrTypePayToPubKeyHash, pre)
+	case 0x08:
+		return newAddressCash(data[1:], AddrTypePayToScriptHash, pre)
+	default:
+		return nil, ErrUnknownAddressType
+	}
+}
+
+func createChecksum(prefix string, payload []byte) []byte {
+	enc := cat(expandPrefix(prefix), payload)
+	enc = cat(enc, []byte{0, 0, 0, 0, 0, 0, 0, 0})
+	mod := polyMod(enc)
+	ret := make([]byte, 8)
+	for i := 0; i < 8; i++ {
+		ret[i] = byte((mod >> uint(5*(7-i))) & 0x1f)
+	}
+	return ret
+}
+
+func DecodeCashAddress(str string) (string, []byte, error) {
+	// Go over the string and do some sanity checks.
+	lower, upper := false, false
+	prefixSize := 0
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if c >= 'a' && c <= 'z' {
+			lower = true
+			continue
+		}
+
+		if c >= 'A' && c <= 'Z' {
+			upper = true
+			continue
+		}
+
+		if c >= '0' && c <= '9' {
+			// We cannot have numbers in the prefix.
+			if prefixSize == 0 {
+				return "", nil, errors.New("addresses cannot have numbers in the prefix")
+			}
+
+			continue
+		}
+
+		if c == ':' {
+			// The separator must not be the first character, and there must not
+			// be 2 separators.
+			if i == 0 || prefixSize != 0 {
+				return "", nil, errors.New("the separator must not be the first character")
+			}
+
+			prefixSize = i
+			continue
+		}
+
+		// We have an unexpected character.
+		return "", nil, errors.New("unexpected character")
+	}
+
+	// We must have a prefix and a data part and we can't have both uppercase
+	// and lowercase.
+	if prefixSize == 0 {
+		return "", nil, errors.New("address must have a prefix")
+	}
+
+	if upper && lower {
+		return "", nil, errors.New("addresses cannot use both upper and lower case characters")
+	}
+
+	// Get the prefix.
+	var prefix string
+	for i := 0; i < prefixSize; i++ {
+		prefix += string(lowerCase(str[i]))
+	}
+
+	// Decode values.
+	valuesSize := len(str) - 1 - prefixSize
+	values := make([]byte, valuesSize)
+	for i := 0; i < valuesSize; i++ {
+		c := str[i+prefixSize+1]
+		// We have an invalid char in there.
+		if c > 127 || CharsetRev[c] == -1 {
+			return "", nil, errors.New("invalid character")
+		}
+
+		values[i] = byte(CharsetRev[c])
+	}
+
+	// Verify the checksum.
+	if !verifyChecksum(prefix, values) {
+		return "", nil, ErrChecksumMismatch
+	}
+
+	return prefix, values[:len(values)-8], nil
+}
+
+// Base32 conversion contains some licensed code
+// https://github.com/sipa/bech32/blob/master/ref/go/src/bech32/bech32.go
+// Copyright (c) 2017 Takatoshi Nakagawa
+// MIT License
+func convertBits(data []byte, fromBits uint, tobits uint, pad bool) ([]byte, error) {
+	// General power-of-2 base conversion.
+	var uintArr []uint
+	for _, i := range data {
+		uintArr = append(uintArr, uint(i))
+	}
+	acc := uint(0)
+	bits := uint(0)
+	var ret []uint
+	maxv := uint((1 << tobits) - 1)
+	maxAcc := uint((1 << (fromBits + tobits - 1)) - 1)
+	for _, value := range uintArr {
+		acc = ((acc << fromBits) | value) & maxAcc
+		bits += fromBits
+		for bits >= tobits {
+			bits -= tobits
+			ret = append(ret, (acc>>bits)&maxv)
+		}
+	}
+	if pad {
+		if bits > 0 {
+			ret = append(ret, (acc<<(tobits-bits))&maxv)
+		}
+	} else if bits >= fromBits || ((acc<<(tobits-bits))&maxv) != 0 {
+		return []byte{}, errors.New("encoding padding error")
+	}
+	var dataArr []byte
+	for _, i := range ret {
+		dataArr = append(dataArr, byte(i))
+	}
+	return dataArr, nil
+}
+
+func lowerCase(c byte) byte {
+	// ASCII black magic.
+	return c | 0x20
+}
+
+func verifyChecksum(prefix string, payload []byte) bool {
+	return polyMod(cat(expandPrefix(prefix), payload)) == 0
+}
+
+var CharsetRev = [128]int8{
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 15, -1, 10, 17, 21, 20, 26, 30, 7,
+	5, -1, -1, -1, -1, -1, -1, -1, 29, -1, 24, 13, 25, 9, 8, 23, -1, 18, 22,
+	31, 27, 19, -1, 1, 0, 3, 16, 11, 28, 12, 14, 6, 4, 2, -1, -1, -1, -1,
+	-1, -1, 29, -1, 24, 13, 25, 9, 8, 23, -1, 18, 22, 31, 27, 19, -1, 1, 0,
+	3, 16, 11, 28, 12, 14, 6, 4, 2, -1, -1, -1, -1, -1,
+}
+
+func polyMod(v []byte) uint64 {
+	c := uint64(1)
+	for _, d := range v {
+		c0 := byte(c >> 35)
+
+		c = ((c & 0x07ffffffff) << 5) ^ uint64(d)
+
+		if c0&0x01 > 0 {
+			c ^= 0x98f2bc8e61
+		}
+
+		if c0&0x02 > 0 {
+			c ^= 0x79b76d99e2
+		}
+
+		if c0&0x04 > 0 {
+			c ^= 0xf33e5fb3c4
+		}
+
+		if c0&0x08 > 0 {
+			c ^= 0xae2eabe2a8
+		}
+
+		if c0&0x10 > 0 {
+			c ^= 0x1e4f43e470
+		}
+	}
+	return c ^ 1
+}
+
+func cat(x, y []byte) []byte {
+	return append(x, y...)
+}
+
+func expandPrefix(prefix string) []byte {
+	ret := make([]byte, len(prefix)+1)
+	for i := 0; i < len(prefix); i++ {
+		ret[i] = prefix[i] & 0x1f
+	}
+
+	ret[len(prefix)] = 0
+	return ret
+}
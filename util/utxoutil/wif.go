@@ -0,0 +1,54 @@
+package utxoutil
+
+import (
+	"errors"
+
+	"github.com/suyhuai/addressutil/base58"
+	btcec "github.com/suyhuai/addressutil/ecc"
+	"github.com/suyhuai/addressutil/util"
+)
+
+// compressMagic is the byte appended to the private key of a WIF string
+// whose public key is serialized compressed.
+const compressMagic = 0x01
+
+var ErrMalformedPrivateKey = errors.New("malformed private key")
+
+// EncodeWIF returns the wallet import format encoding of priv for the
+// network net.  compress selects whether the key's address is derived from
+// its compressed public key.
+func EncodeWIF(priv *btcec.PrivateKey, compress bool, net *util.Params) string {
+	payload := priv.Serialize()
+	if compress {
+		payload = append(payload, compressMagic)
+	}
+	return base58.CheckEncode(payload, net.PrivateKeyID)
+}
+
+// DecodeWIF decodes the wallet import format string wif of the network net
+// and reports whether it is marked as using a compressed public key.
+func DecodeWIF(wif string, net *util.Params) (*btcec.PrivateKey, bool, error) {
+	payload, version, err := base58.CheckDecode(wif)
+	if err != nil {
+		return nil, false, err
+	}
+	if version != net.PrivateKeyID {
+		return nil, false, ErrMalformedPrivateKey
+	}
+
+	compress := false
+	switch len(payload) {
+	case btcec.PrivKeyBytesLen + 1:
+		if payload[btcec.PrivKeyBytesLen] != compressMagic {
+			return nil, false, ErrMalformedPrivateKey
+		}
+		compress = true
+		payload = payload[:btcec.PrivKeyBytesLen]
+	case btcec.PrivKeyBytesLen:
+	default:
+		return nil, false, ErrMalformedPrivateKey
+	}
+
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), payload)
+	return priv, compress, nil
+}
@@ -0,0 +1,21 @@
+package vdsutil
+
+import "github.com/suyhuai/addressutil/util"
+
+// MainNetParams defines the address encoding of the main Vollar network.
+// Addresses carry two byte base58 version prefixes, private keys the one byte
+// WIF prefix of Bitcoin.
+var MainNetParams = util.Params{
+	Name:                 "mainnet",
+	PubKeyHashAddrPrefix: []byte{0x10, 0x1c}, // starts with Vc
+	ScriptHashAddrPrefix: []byte{0x10, 0x41}, // starts with Vs
+	PrivateKeyID:         0x80,               // starts with 5 (uncompressed) or K/L (compressed)
+}
+
+// TestNetParams defines the address encoding of the Vollar test network.
+var TestNetParams = util.Params{
+	Name:                 "testnet",
+	PubKeyHashAddrPrefix: []byte{0x1d, 0x25}, // starts with tm
+	ScriptHashAddrPrefix: []byte{0x1c, 0xba}, // starts with t2
+	PrivateKeyID:         0xef,               // starts with 9 (uncompressed) or c (compressed)
+}
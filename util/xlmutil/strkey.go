@@ -0,0 +1,132 @@
+package xlmutil
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+)
+
+// VersionByte is the first byte of a StrKey payload, which selects the
+// leading character of its string form.
+type VersionByte byte
+
+const (
+	VersionAccountID    VersionByte = 6 << 3  // G…
+	VersionMuxedAccount VersionByte = 12 << 3 // M…
+	VersionSeed         VersionByte = 18 << 3 // S…
+)
+
+// KeySize is the length of an ed25519 public key or seed.
+const KeySize = 32
+
+var (
+	ErrStrKeyFormat = errors.New("invalid Stellar StrKey")
+	ErrVersionByte  = errors.New("unexpected Stellar StrKey version byte")
+	ErrChecksum     = errors.New("Stellar StrKey checksum mismatch")
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Encode returns the StrKey of payload under the version byte version: the
+// base32 encoding of the version byte, payload and their CRC16-XModem
+// checksum in little-endian order.
+func Encode(version VersionByte, payload []byte) string {
+	data := append([]byte{byte(version)}, payload...)
+	data = binary.LittleEndian.AppendUint16(data, crc16(data))
+	return encoding.EncodeToString(data)
+}
+
+// Decode returns the payload of the StrKey s, which must carry the version
+// byte version.
+func Decode(version VersionByte, s string) ([]byte, error) {
+	data, err := encoding.DecodeString(s)
+	// Reject strings whose unused trailing bits are set, which would
+	// otherwise give several strings for the same payload.
+	if err != nil || len(data) < 3 || encoding.EncodeToString(data) != s {
+		return nil, ErrStrKeyFormat
+	}
+
+	body := data[:len(data)-2]
+	if binary.LittleEndian.Uint16(data[len(body):]) != crc16(body) {
+		return nil, ErrChecksum
+	}
+	if VersionByte(body[0]) != version {
+		return nil, ErrVersionByte
+	}
+	return body[1:], nil
+}
+
+// EncodeAccountID returns the "G…" account ID of the ed25519 public key pub.
+func EncodeAccountID(pub []byte) (string, error) {
+	if len(pub) != KeySize {
+		return "", ErrStrKeyFormat
+	}
+	return Encode(VersionAccountID, pub), nil
+}
+
+// DecodeAccountID returns the ed25519 public key of the account ID addr.
+func DecodeAccountID(addr string) ([]byte, error) {
+	return decodeKey(VersionAccountID, addr)
+}
+
+// EncodeSeed returns the "S…" secret seed string of the ed25519 seed seed.
+func EncodeSeed(seed []byte) (string, error) {
+	if len(seed) != KeySize {
+		return "", ErrStrKeyFormat
+	}
+	return Encode(VersionSeed, seed), nil
+}
+
+// DecodeSeed returns the ed25519 seed of the secret seed string s.
+func DecodeSeed(s string) ([]byte, error) {
+	return decodeKey(VersionSeed, s)
+}
+
+// EncodeMuxedAccount returns the "M…" address of the account pub with the
+// 64-bit ID id, as defined by SEP-23.
+func EncodeMuxedAccount(pub []byte, id uint64) (string, error) {
+	if len(pub) != KeySize {
+		return "", ErrStrKeyFormat
+	}
+	return Encode(VersionMuxedAccount, binary.BigEndian.AppendUint64(pub[:KeySize:KeySize], id)), nil
+}
+
+// DecodeMuxedAccount returns the ed25519 public key and ID of the muxed
+// account addr.
+func DecodeMuxedAccount(addr string) ([]byte, uint64, error) {
+	payload, err := Decode(VersionMuxedAccount, addr)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(payload) != KeySize+8 {
+		return nil, 0, ErrStrKeyFormat
+	}
+	return payload[:KeySize], binary.BigEndian.Uint64(payload[KeySize:]), nil
+}
+
+func decodeKey(version VersionByte, s string) ([]byte, error) {
+	payload, err := Decode(version, s)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) != KeySize {
+		return nil, ErrStrKeyFormat
+	}
+	return payload, nil
+}
+
+// crc16 returns the CRC16-XModem checksum of data.
+func crc16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,98 @@
+package xrputil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/suyhuai/addressutil/base58"
+	"github.com/suyhuai/addressutil/hash160"
+)
+
+// AccountIDLength is the length of an XRP Ledger account ID in bytes.
+const AccountIDLength = 20
+
+// ED25519Prefix marks the serialized form of an ed25519 public key, which the
+// XRP Ledger extends to 33 bytes like a compressed secp256k1 key.
+const ED25519Prefix = 0xed
+
+// accountIDVersion is the version byte of classic ("r…") addresses.
+const accountIDVersion = 0x00
+
+var (
+	xAddressMainPrefix = []byte{0x05, 0x44} // starts with X
+	xAddressTestPrefix = []byte{0x04, 0x93} // starts with T
+)
+
+var (
+	ErrAddressFormat  = errors.New("invalid XRP address")
+	ErrXAddressFormat = errors.New("invalid XRP X-address")
+)
+
+// AccountID returns the account ID of the 33 byte serialized public key
+// pubKey: a compressed secp256k1 key or an ed25519 key behind ED25519Prefix.
+func AccountID(pubKey []byte) []byte {
+	return hash160.Hash160(pubKey)
+}
+
+// EncodeClassicAddress returns the classic ("r…") address of accountID.
+func EncodeClassicAddress(accountID []byte) (string, error) {
+	if len(accountID) != AccountIDLength {
+		return "", ErrAddressFormat
+	}
+	return base58.RippleAlphabet.CheckEncode(accountID, accountIDVersion), nil
+}
+
+// DecodeClassicAddress returns the account ID of the classic address addr.
+func DecodeClassicAddress(addr string) ([]byte, error) {
+	accountID, version, err := base58.RippleAlphabet.CheckDecode(addr)
+	if err != nil || version != accountIDVersion || len(accountID) != AccountIDLength {
+		return nil, ErrAddressFormat
+	}
+	return accountID, nil
+}
+
+// EncodeXAddress returns the XLS-5d X-address of accountID, which embeds the
+// destination tag tag when hasTag is set.  test selects the testnet ("T…")
+// form.
+func EncodeXAddress(accountID []byte, tag uint32, hasTag, test bool) (string, error) {
+	if len(accountID) != AccountIDLength {
+		return "", ErrAddressFormat
+	}
+
+	prefix := xAddressMainPrefix
+	if test {
+		prefix = xAddressTestPrefix
+	}
+
+	// The flag byte is followed by the tag as a little endian uint64 whose
+	// upper half is reserved and must be zero.
+	payload := make([]byte, AccountIDLength+9)
+	copy(payload, accountID)
+	if hasTag {
+		payload[AccountIDLength] = 1
+		binary.LittleEndian.PutUint32(payload[AccountIDLength+1:], tag)
+	}
+	return base58.RippleAlphabet.CheckEncodePrefix(payload, prefix), nil
+}
+
+// DecodeXAddress decodes the X-address addr into its account ID and
+// destination tag, and reports whether it is a testnet address.
+func DecodeXAddress(addr string) (accountID []byte, tag uint32, hasTag, test bool, err error) {
+	payload, prefix, err := base58.RippleAlphabet.CheckDecodePrefix(addr, xAddressMainPrefix, xAddressTestPrefix)
+	if err != nil || len(payload) != AccountIDLength+9 {
+		return nil, 0, false, false, ErrXAddressFormat
+	}
+
+	flag := payload[AccountIDLength]
+	tag = binary.LittleEndian.Uint32(payload[AccountIDLength+1:])
+	reserved := payload[AccountIDLength+5:]
+	switch {
+	case flag > 1,
+		flag == 0 && tag != 0,
+		!bytes.Equal(reserved, make([]byte, 4)):
+		return nil, 0, false, false, ErrXAddressFormat
+	}
+
+	return payload[:AccountIDLength], tag, flag == 1, bytes.Equal(prefix, xAddressTestPrefix), nil
+}
@@ -0,0 +1,129 @@
+package xtzutil
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/suyhuai/addressutil/base58"
+	"github.com/suyhuai/addressutil/blake2b"
+)
+
+// Curve is the signature scheme of a Tezos key.
+type Curve int
+
+const (
+	Ed25519 Curve = iota
+	Secp256k1
+	P256
+)
+
+// HashSize is the length of the blake2b-160 hash an address encodes.
+const HashSize = 20
+
+// Base58check prefixes of implicit ("tz…") and originated ("KT1…")
+// addresses.
+var (
+	PrefixTZ1 = []byte{6, 161, 159}
+	PrefixTZ2 = []byte{6, 161, 161}
+	PrefixTZ3 = []byte{6, 161, 164}
+	PrefixKT1 = []byte{2, 90, 121}
+)
+
+// Base58check prefixes of public keys.
+var (
+	PrefixEdPK = []byte{13, 15, 37, 217}
+	PrefixSpPK = []byte{3, 254, 226, 86}
+	PrefixP2PK = []byte{3, 178, 139, 127}
+)
+
+var (
+	ErrAddressFormat   = errors.New("invalid Tezos address")
+	ErrPublicKeyFormat = errors.New("invalid Tezos public key")
+	ErrCurve           = errors.New("unknown Tezos key curve")
+)
+
+// curveParams describes how the keys of a curve are serialized and which
+// implicit address they hash to.
+type curveParams struct {
+	addrPrefix []byte
+	keyPrefix  []byte
+	keySize    int
+}
+
+var curves = map[Curve]curveParams{
+	Ed25519:   {PrefixTZ1, PrefixEdPK, 32},
+	Secp256k1: {PrefixTZ2, PrefixSpPK, 33},
+	P256:      {PrefixTZ3, PrefixP2PK, 33},
+}
+
+// EncodeAddress returns the address of the blake2b-160 hash hash under one
+// of the address prefixes.
+func EncodeAddress(hash, prefix []byte) (string, error) {
+	if len(hash) != HashSize {
+		return "", ErrAddressFormat
+	}
+	return base58.CheckEncodePrefix(hash, prefix), nil
+}
+
+// DecodeAddress returns the hash and prefix of the implicit or originated
+// address addr.
+func DecodeAddress(addr string) ([]byte, []byte, error) {
+	hash, prefix, err := base58.CheckDecodePrefix(addr, PrefixTZ1, PrefixTZ2, PrefixTZ3, PrefixKT1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(hash) != HashSize {
+		return nil, nil, ErrAddressFormat
+	}
+	return hash, prefix, nil
+}
+
+// PublicKeyHash returns the implicit address of the serialized public key
+// key of curve: tz1 for ed25519, tz2 for secp256k1 and tz3 for P-256 keys.
+// secp256k1 and P-256 keys are in compressed form.
+func PublicKeyHash(curve Curve, key []byte) (string, error) {
+	params, err := lookupCurve(curve, key)
+	if err != nil {
+		return "", err
+	}
+	return EncodeAddress(blake2b.Sum160(key), params.addrPrefix)
+}
+
+// EncodePublicKey returns the "edpk…", "sppk…" or "p2pk…" string of the
+// serialized public key key of curve.
+func EncodePublicKey(curve Curve, key []byte) (string, error) {
+	params, err := lookupCurve(curve, key)
+	if err != nil {
+		return "", err
+	}
+	return base58.CheckEncodePrefix(key, params.keyPrefix), nil
+}
+
+// DecodePublicKey returns the curve and serialized form of the public key
+// string s.
+func DecodePublicKey(s string) (Curve, []byte, error) {
+	key, prefix, err := base58.CheckDecodePrefix(s, PrefixEdPK, PrefixSpPK, PrefixP2PK)
+	if err != nil {
+		return 0, nil, err
+	}
+	for curve, params := range curves {
+		if bytes.Equal(params.keyPrefix, prefix) {
+			if len(key) != params.keySize {
+				return 0, nil, ErrPublicKeyFormat
+			}
+			return curve, key, nil
+		}
+	}
+	return 0, nil, ErrCurve
+}
+
+func lookupCurve(curve Curve, key []byte) (curveParams, error) {
+	params, ok := curves[curve]
+	if !ok {
+		return curveParams{}, ErrCurve
+	}
+	if len(key) != params.keySize {
+		return curveParams{}, ErrPublicKeyFormat
+	}
+	return params, nil
+}
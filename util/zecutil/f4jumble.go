@@ -0,0 +1,88 @@
+package zecutil
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/suyhuai/addressutil/blake2b"
+)
+
+// The message lengths F4Jumble is defined for, see ZIP 316.
+const (
+	minJumbleLen = 48
+	maxJumbleLen = 4194368
+)
+
+// ErrJumbleLength is returned for messages F4Jumble is not defined for.
+var ErrJumbleLength = errors.New("invalid F4Jumble message length")
+
+// F4Jumble applies the unkeyed 4-round Feistel permutation of ZIP 316 to m,
+// which makes every byte of an encoded unified address depend on every byte
+// of its receivers.
+func F4Jumble(m []byte) ([]byte, error) {
+	if len(m) < minJumbleLen || len(m) > maxJumbleLen {
+		return nil, ErrJumbleLength
+	}
+	ll := leftLen(len(m))
+	a, b := clone(m[:ll]), clone(m[ll:])
+
+	xorInto(b, jumbleG(0, a, len(b)))
+	xorInto(a, jumbleH(0, b, ll))
+	xorInto(b, jumbleG(1, a, len(b)))
+	xorInto(a, jumbleH(1, b, ll))
+
+	return append(a, b...), nil
+}
+
+// F4JumbleInv inverts F4Jumble.
+func F4JumbleInv(m []byte) ([]byte, error) {
+	if len(m) < minJumbleLen || len(m) > maxJumbleLen {
+		return nil, ErrJumbleLength
+	}
+	ll := leftLen(len(m))
+	c, d := clone(m[:ll]), clone(m[ll:])
+
+	xorInto(c, jumbleH(1, d, ll))
+	xorInto(d, jumbleG(1, c, len(d)))
+	xorInto(c, jumbleH(0, d, ll))
+	xorInto(d, jumbleG(0, c, len(d)))
+
+	return append(c, d...), nil
+}
+
+func leftLen(n int) int {
+	if n/2 < blake2b.Size {
+		return n / 2
+	}
+	return blake2b.Size
+}
+
+// jumbleH is the round function H_i of ZIP 316, a BLAKE2b digest as long as
+// the left half of the message.
+func jumbleH(i byte, u []byte, n int) []byte {
+	h, _ := blake2b.Sum(u, n, []byte{'U', 'A', '_', 'F', '4', 'J', 'u', 'm', 'b', 'l', 'e', '_', 'H', i, 0, 0})
+	return h
+}
+
+// jumbleG is the round function G_i of ZIP 316, BLAKE2b-512 digests of u
+// under a counter personalization concatenated to n bytes.
+func jumbleG(i byte, u []byte, n int) []byte {
+	out := make([]byte, 0, n+blake2b.Size)
+	personal := []byte{'U', 'A', '_', 'F', '4', 'J', 'u', 'm', 'b', 'l', 'e', '_', 'G', i, 0, 0}
+	for j := 0; len(out) < n; j++ {
+		binary.LittleEndian.PutUint16(personal[14:], uint16(j))
+		h, _ := blake2b.Sum(u, blake2b.Size, personal)
+		out = append(out, h...)
+	}
+	return out[:n]
+}
+
+func xorInto(dst, src []byte) {
+	for i := range dst {
+		dst[i] ^= src[i]
+	}
+}
+
+func clone(b []byte) []byte {
+	return append([]byte(nil), b...)
+}
@@ -0,0 +1,44 @@
+package zecutil
+
+import "github.com/suyhuai/addressutil/util"
+
+// Params holds the address encoding magics of a Zcash network.  Transparent
+// addresses carry two byte base58 version prefixes and are described by the
+// embedded util.Params.
+type Params struct {
+	util.Params
+
+	// UnifiedHRP is the bech32m human-readable part of unified addresses.
+	UnifiedHRP string
+}
+
+// MainNetParams defines the address encoding of the main Zcash network.
+var MainNetParams = Params{
+	Params: util.Params{
+		Name:                 "mainnet",
+		PubKeyHashAddrPrefix: []byte{0x1c, 0xb8}, // starts with t1
+		ScriptHashAddrPrefix: []byte{0x1c, 0xbd}, // starts with t3
+	},
+	UnifiedHRP: "u",
+}
+
+// TestNetParams defines the address encoding of the Zcash test network.
+var TestNetParams = Params{
+	Params: util.Params{
+		Name:                 "testnet",
+		PubKeyHashAddrPrefix: []byte{0x1d, 0x25}, // starts with tm
+		ScriptHashAddrPrefix: []byte{0x1c, 0xba}, // starts with t2
+	},
+	UnifiedHRP: "utest",
+}
+
+// RegressionNetParams defines the address encoding of the Zcash regression
+// test network, which shares the transparent prefixes of testnet.
+var RegressionNetParams = Params{
+	Params: util.Params{
+		Name:                 "regtest",
+		PubKeyHashAddrPrefix: []byte{0x1d, 0x25}, // starts with tm
+		ScriptHashAddrPrefix: []byte{0x1c, 0xba}, // starts with t2
+	},
+	UnifiedHRP: "uregtest",
+}
@@ -0,0 +1,224 @@
+package zecutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/suyhuai/addressutil/bech32"
+)
+
+// Typecode identifies the kind of a receiver in a unified address.
+type Typecode uint64
+
+const (
+	TypeP2PKH   Typecode = 0x00
+	TypeP2SH    Typecode = 0x01
+	TypeSapling Typecode = 0x02
+	TypeOrchard Typecode = 0x03
+)
+
+// receiverSizes holds the length of the receivers of known typecodes.
+var receiverSizes = map[Typecode]int{
+	TypeP2PKH:   20,
+	TypeP2SH:    20,
+	TypeSapling: 43,
+	TypeOrchard: 43,
+}
+
+// paddingLen is the length of the human-readable part padding appended to
+// the encoded receivers before jumbling.
+const paddingLen = 16
+
+var (
+	ErrUnifiedAddress      = errors.New("invalid unified address")
+	ErrNoShieldedReceiver  = errors.New("unified address has no shielded receiver")
+	ErrReceiverOrder       = errors.New("unified address receivers out of order")
+	ErrTransparentConflict = errors.New("unified address has both P2PKH and P2SH receivers")
+)
+
+// Receiver is a single address component of a unified address.
+type Receiver struct {
+	Typecode Typecode
+	Data     []byte
+}
+
+// UnifiedAddress is a decoded ZIP 316 unified address.  Receivers are kept
+// in ascending typecode order, including ones of typecodes this package
+// does not know.
+type UnifiedAddress struct {
+	Receivers []Receiver
+}
+
+// Receiver returns the receiver of typecode t, if the address has one.
+func (ua *UnifiedAddress) Receiver(t Typecode) ([]byte, bool) {
+	for _, r := range ua.Receivers {
+		if r.Typecode == t {
+			return r.Data, true
+		}
+	}
+	return nil, false
+}
+
+// Transparent returns the transparent receiver of the address and whether it
+// is a P2PKH or P2SH receiver.
+func (ua *UnifiedAddress) Transparent() (Typecode, []byte, bool) {
+	for _, t := range []Typecode{TypeP2PKH, TypeP2SH} {
+		if data, ok := ua.Receiver(t); ok {
+			return t, data, true
+		}
+	}
+	return 0, nil, false
+}
+
+func (ua *UnifiedAddress) validate() error {
+	shielded := false
+	for i, r := range ua.Receivers {
+		if i > 0 && r.Typecode <= ua.Receivers[i-1].Typecode {
+			return ErrReceiverOrder
+		}
+		if size, ok := receiverSizes[r.Typecode]; ok && len(r.Data) != size {
+			return ErrUnifiedAddress
+		}
+		if r.Typecode != TypeP2PKH && r.Typecode != TypeP2SH {
+			shielded = true
+		}
+	}
+	if _, ok := ua.Receiver(TypeP2PKH); ok {
+		if _, ok := ua.Receiver(TypeP2SH); ok {
+			return ErrTransparentConflict
+		}
+	}
+	if !shielded {
+		return ErrNoShieldedReceiver
+	}
+	return nil
+}
+
+// EncodeUnifiedAddress encodes ua as a bech32m string with the
+// human-readable part hrp.
+func EncodeUnifiedAddress(ua *UnifiedAddress, hrp string) (string, error) {
+	if err := ua.validate(); err != nil {
+		return "", err
+	}
+
+	var buf []byte
+	for _, r := range ua.Receivers {
+		buf = appendCompactSize(buf, uint64(r.Typecode))
+		buf = appendCompactSize(buf, uint64(len(r.Data)))
+		buf = append(buf, r.Data...)
+	}
+	buf = append(buf, padding(hrp)...)
+
+	jumbled, err := F4Jumble(buf)
+	if err != nil {
+		return "", err
+	}
+	data, err := bech32.ConvertBits(jumbled, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.EncodeM(hrp, data)
+}
+
+// DecodeUnifiedAddress decodes the unified address addr, which must carry
+// the human-readable part hrp.
+func DecodeUnifiedAddress(addr, hrp string) (*UnifiedAddress, error) {
+	decodedHRP, data, version, err := bech32.DecodeNoLimit(addr)
+	if err != nil {
+		return nil, err
+	}
+	if version != bech32.VersionM || decodedHRP != hrp {
+		return nil, ErrUnifiedAddress
+	}
+	jumbled, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := F4JumbleInv(jumbled)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(buf) - paddingLen
+	if !bytes.Equal(buf[n:], padding(hrp)) {
+		return nil, ErrUnifiedAddress
+	}
+	buf = buf[:n]
+
+	ua := &UnifiedAddress{}
+	for len(buf) > 0 {
+		typecode, rest, ok := readCompactSize(buf)
+		if !ok {
+			return nil, ErrUnifiedAddress
+		}
+		size, rest, ok := readCompactSize(rest)
+		if !ok || size > uint64(len(rest)) {
+			return nil, ErrUnifiedAddress
+		}
+		ua.Receivers = append(ua.Receivers, Receiver{
+			Typecode: Typecode(typecode),
+			Data:     rest[:size:size],
+		})
+		buf = rest[size:]
+	}
+	if err := ua.validate(); err != nil {
+		return nil, err
+	}
+	return ua, nil
+}
+
+// padding returns hrp zero padded to 16 bytes.
+func padding(hrp string) []byte {
+	p := make([]byte, paddingLen)
+	copy(p, hrp)
+	return p
+}
+
+func appendCompactSize(b []byte, v uint64) []byte {
+	switch {
+	case v < 0xfd:
+		return append(b, byte(v))
+	case v <= 0xffff:
+		return binary.LittleEndian.AppendUint16(append(b, 0xfd), uint16(v))
+	case v <= 0xffffffff:
+		return binary.LittleEndian.AppendUint32(append(b, 0xfe), uint32(v))
+	default:
+		return binary.LittleEndian.AppendUint64(append(b, 0xff), v)
+	}
+}
+
+// readCompactSize reads a canonically encoded compact size from the front of
+// b and returns the rest of b.
+func readCompactSize(b []byte) (uint64, []byte, bool) {
+	if len(b) == 0 {
+		return 0, nil, false
+	}
+	var v, minValue uint64
+	var n int
+	switch b[0] {
+	case 0xfd:
+		n, minValue = 2, 0xfd
+	case 0xfe:
+		n, minValue = 4, 0x10000
+	case 0xff:
+		n, minValue = 8, 0x100000000
+	default:
+		return uint64(b[0]), b[1:], true
+	}
+	if len(b) < 1+n {
+		return 0, nil, false
+	}
+	switch n {
+	case 2:
+		v = uint64(binary.LittleEndian.Uint16(b[1:]))
+	case 4:
+		v = uint64(binary.LittleEndian.Uint32(b[1:]))
+	default:
+		v = binary.LittleEndian.Uint64(b[1:])
+	}
+	if v < minValue {
+		return 0, nil, false
+	}
+	return v, b[1+n:], true
+}
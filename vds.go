@@ -1,39 +1,56 @@
 package addressutil
 
 import (
-	"crypto/sha256"
 	"encoding/hex"
-	"errors"
-	"github.com/suyhuai/addressutil/base58"
-	"github.com/suyhuai/addressutil/ecc"
+
 	"github.com/suyhuai/addressutil/hash160"
-	"github.com/suyhuai/addressutil/ripemd160"
 	"github.com/suyhuai/addressutil/util"
-	"github.com/suyhuai/addressutil/util/btcutil"
+	"github.com/suyhuai/addressutil/util/utxoutil"
+	"github.com/suyhuai/addressutil/util/vdsutil"
 )
 
 var (
-	P2PKHAddrId = []byte{0x10, 0x1C}
-	MainAddrId  = "101c"
+	// P2PKHAddrId is the version prefix of Vollar mainnet P2PKH addresses.
+	//
+	// Deprecated: use vdsutil.MainNetParams.PubKeyHashAddrPrefix.
+	P2PKHAddrId = append([]byte(nil), vdsutil.MainNetParams.PubKeyHashAddrPrefix...)
+
+	// MainAddrId is the hex encoding of P2PKHAddrId.
+	//
+	// Deprecated: use vdsutil.MainNetParams.PubKeyHashAddrPrefix.
+	MainAddrId = hex.EncodeToString(P2PKHAddrId)
 )
 
 type VDSAddress struct {
+	params *util.Params
 	addr   string
 	pubKey []byte
 }
 
-func NewVDSAddress(pubKey []byte) (*VDSAddress, error) {
-	addr, err := VdsAddrFromPub(pubKey)
+// NewVDSAddress derives the P2PKH address of pubKey on the Vollar network
+// net.  Vollar addresses always hash the compressed public key.
+func NewVDSAddress(pubKey interface{}, net Network) (*VDSAddress, error) {
+	pub, _, err := parsePubKey(pubKey)
 	if err != nil {
 		return nil, err
 	}
 
-	address := &VDSAddress{
-		pubKey: pubKey,
-		addr:   addr,
+	params, err := vdsNetParams(net)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized := pub.SerializeCompressed()
+	addr, err := utxoutil.NewAddressPubKeyHash(hash160.Hash160(serialized), params)
+	if err != nil {
+		return nil, err
 	}
 
-	return address, nil
+	return &VDSAddress{
+		params: params,
+		addr:   addr.EncodeAddress(),
+		pubKey: serialized,
+	}, nil
 }
 
 func (t *VDSAddress) String() string {
@@ -44,57 +61,44 @@ func (t *VDSAddress) Url() string {
 	return t.String()
 }
 
-func encodeAddr(addrHash []byte, prefix []byte) (string, error) {
-	if len(addrHash) != ripemd160.Size {
-		return "", errors.New("incorrect hash length")
-	}
-
-	body := append(prefix, addrHash[:ripemd160.Size]...)
-	chk := addrChecksum(body)
-
-	var checksum [4]byte
-	copy(checksum[:], chk[:4])
-
-	return base58.Encode(append(body, checksum[:]...)), nil
-}
-
-func addrChecksum(input []byte) []byte {
-	first := sha256.Sum256(input)
-	second := sha256.Sum256(first[:])
-	return second[:4]
-}
-
+// VdsAddrFromPub returns the mainnet P2PKH address of the serialized public
+// key pub.
+//
+// Deprecated: use NewVDSAddress.
 func VdsAddrFromPub(pub []byte) (string, error) {
-	pubKey, err := ecc.ParsePubKey(pub, ecc.S256())
-	if err != nil {
-		return "", err
-	}
-
-	addrPubKey, err := btcutil.NewAddressPubKey(pubKey.SerializeCompressed(), &util.Params{})
+	addr, err := NewVDSAddress(pub, MainNet)
 	if err != nil {
 		return "", err
 	}
-	address, err := encodeAddr(hash160.Hash160(addrPubKey.ScriptAddress())[:ripemd160.Size], P2PKHAddrId)
-	return address, err
+	return addr.String(), nil
 }
 
-func CheckVDSAddress(address string) bool {
-	addr := base58.Decode(address)
-	if len(addr) != 26 {
-		return false
-	}
-	body := addr[:len(addr)-4]
+// CheckVDSAddress reports whether address is a P2PKH or P2SH address of the
+// Vollar network net.
+func CheckVDSAddress(address string, net Network) bool {
+	_, err := DecodeVDSAddress(address, net)
+	return err == nil
+}
 
-	prefix := hex.EncodeToString(body[:len(body)-ripemd160.Size])
-	if prefix != MainAddrId {
-		return false
+// DecodeVDSAddress decodes the P2PKH or P2SH address address of the Vollar
+// network net.  The result is a *utxoutil.AddressPubKeyHash or a
+// *utxoutil.AddressScriptHash.
+func DecodeVDSAddress(address string, net Network) (utxoutil.Address, error) {
+	params, err := vdsNetParams(net)
+	if err != nil {
+		return nil, err
 	}
+	return decodeBase58Address(address, params)
+}
 
-	checksum := hex.EncodeToString(addr[len(addr)-4:])
-	checksum2 := hex.EncodeToString(addrChecksum(body))
-	if checksum != checksum2 {
-		return false
+// vdsNetParams returns the address parameters of the Vollar network net.
+func vdsNetParams(net Network) (*util.Params, error) {
+	switch net {
+	case MainNet:
+		return &vdsutil.MainNetParams, nil
+	case TestNet:
+		return &vdsutil.TestNetParams, nil
+	default:
+		return nil, ErrUnsupportedNetwork
 	}
-
-	return true
 }
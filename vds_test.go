@@ -1,8 +1,15 @@
 package addressutil
 
 import (
+	"bytes"
 	"encoding/hex"
 	"testing"
+
+	"github.com/suyhuai/addressutil/base58"
+	"github.com/suyhuai/addressutil/ecc"
+	"github.com/suyhuai/addressutil/util"
+	"github.com/suyhuai/addressutil/util/utxoutil"
+	"github.com/suyhuai/addressutil/util/vdsutil"
 )
 
 var addrPub = map[string]string{
@@ -19,23 +26,133 @@ func TestNewVdsAddress(t *testing.T) {
 			t.Log(err)
 			t.Fail()
 		}
-		if a, err := NewVDSAddress(pub); err != nil {
+		if a, err := NewVDSAddress(pub, MainNet); err != nil {
 			t.Log(err)
 			t.Fail()
 		} else if a.String() != addr {
 			t.Log("Address mismatch", a, addr)
 			t.Fail()
 		}
+		if a, err := VdsAddrFromPub(pub); err != nil || a != addr {
+			t.Log("VdsAddrFromPub mismatch", a, addr, err)
+			t.Fail()
+		}
 	}
 
 }
 
+func TestVdsDeprecatedPrefix(t *testing.T) {
+	// Writing to the deprecated var must not change the mainnet params.
+	saved := P2PKHAddrId[0]
+	defer func() { P2PKHAddrId[0] = saved }()
+	P2PKHAddrId[0] = 0xff
+	if !bytes.Equal(vdsutil.MainNetParams.PubKeyHashAddrPrefix, []byte{0x10, 0x1c}) || MainAddrId != "101c" {
+		t.Log("mainnet params changed through P2PKHAddrId")
+		t.Fail()
+	}
+}
+
 func TestCheckVdsAddress(t *testing.T) {
 	for addr := range addrPub {
-		if !CheckVDSAddress(addr) {
+		if !CheckVDSAddress(addr, MainNet) {
 			t.Log("check address fail: ", addr)
 			t.Fail()
 		}
 	}
 
+	invalidAddresses := []string{
+		"VcoCWoY2gJ3QA2NpCR1LgfirvAj6cE48948",
+		"t1UYsZVJkLPeMjxEtACvSxfWuNmddpWfxzs",
+		"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		"tmLPctKo9j49rtCSKpwEBpLBeykiTGomGQs",
+		"0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+	}
+	for _, addr := range invalidAddresses {
+		if CheckVDSAddress(addr, MainNet) {
+			t.Log("invalid address accepted: ", addr)
+			t.Fail()
+		}
+	}
+
+}
+
+func TestVDSAddressNetworks(t *testing.T) {
+	pub, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	hash, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	tests := []struct {
+		net    Network
+		addr   string
+		script string
+	}{
+		{MainNet, "VcbXoAy8kS3r5ppBF9wjfdvuPiRxgtFqqpF", "VsV37bzAQhizW5rLT4U4TaZyW16VbGmmNHt"},
+		{TestNet, "tmLPctKo9j49rtCSKpwEBpLBeykiTGomGQs", "t2HE5XhuKkka7NpX4D3b5vv4Udn9XGqUwEt"},
+	}
+
+	for _, test := range tests {
+		if a, err := NewVDSAddress(pub, test.net); err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if a.String() != test.addr {
+			t.Log("Address mismatch", a, test.addr)
+			t.Fail()
+		}
+
+		decoded, err := DecodeVDSAddress(test.addr, test.net)
+		if pkh, ok := decoded.(*utxoutil.AddressPubKeyHash); err != nil || !ok || !bytes.Equal(pkh.Hash160()[:], hash) {
+			t.Log("decode P2PKH address fail: ", test.addr, err)
+			t.Fail()
+		}
+		decoded, err = DecodeVDSAddress(test.script, test.net)
+		if sh, ok := decoded.(*utxoutil.AddressScriptHash); err != nil || !ok || !bytes.Equal(sh.Hash160()[:], hash) {
+			t.Log("decode P2SH address fail: ", test.script, err)
+			t.Fail()
+		}
+	}
+
+	if CheckVDSAddress("VcbXoAy8kS3r5ppBF9wjfdvuPiRxgtFqqpF", TestNet) {
+		t.Log("mainnet address accepted on testnet")
+		t.Fail()
+	}
+}
+
+func TestCheckDecodePrefixLongest(t *testing.T) {
+	// Vc addresses start with 0x10 0x1c, which also starts with 0x10.
+	short, long := []byte{0x10}, vdsutil.MainNetParams.PubKeyHashAddrPrefix
+	for _, prefixes := range [][][]byte{{short, long}, {long, short}} {
+		hash, prefix, err := base58.CheckDecodePrefix("VcbXoAy8kS3r5ppBF9wjfdvuPiRxgtFqqpF", prefixes...)
+		if err != nil || !bytes.Equal(prefix, long) || len(hash) != 20 {
+			t.Log("prefix mismatch", prefix, len(hash), err)
+			t.Fail()
+		}
+	}
+}
+
+func TestVDSWIF(t *testing.T) {
+	priv, _ := ecc.PrivKeyFromBytes(ecc.S256(), append(make([]byte, 31), 1))
+	tests := []struct {
+		params   *util.Params
+		compress bool
+		wif      string
+	}{
+		{&vdsutil.MainNetParams, true, "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn"},
+		{&vdsutil.MainNetParams, false, "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf"},
+		{&vdsutil.TestNetParams, true, "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA"},
+	}
+
+	for _, test := range tests {
+		if wif := utxoutil.EncodeWIF(priv, test.compress, test.params); wif != test.wif {
+			t.Log("WIF mismatch", wif, test.wif)
+			t.Fail()
+		}
+		decoded, compress, err := utxoutil.DecodeWIF(test.wif, test.params)
+		if err != nil || compress != test.compress || !bytes.Equal(decoded.Serialize(), priv.Serialize()) {
+			t.Log("decode WIF fail: ", test.wif, err)
+			t.Fail()
+		}
+	}
+
+	if _, _, err := utxoutil.DecodeWIF("cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", &vdsutil.MainNetParams); err == nil {
+		t.Log("testnet WIF accepted on mainnet")
+		t.Fail()
+	}
 }
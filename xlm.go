@@ -0,0 +1,80 @@
+package addressutil
+
+import (
+	"github.com/suyhuai/addressutil/util/xlmutil"
+)
+
+// XLMAddress is a Stellar account ID ("G…").  Addresses parsed from a muxed
+// account ("M…") keep the 64-bit ID it carried.
+type XLMAddress struct {
+	addr   string
+	pubKey []byte
+	id     uint64
+	hasID  bool
+}
+
+// NewXLMAddress returns the account ID of the ed25519 key pubKey.
+func NewXLMAddress(pubKey interface{}) (*XLMAddress, error) {
+	pub, err := parseEd25519PubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := xlmutil.EncodeAccountID(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	return &XLMAddress{
+		addr:   addr,
+		pubKey: pub,
+	}, nil
+}
+
+// ParseXLMAddress parses an account ID or a muxed account.  The ID of a
+// muxed account is available through ID.
+func ParseXLMAddress(address string) (*XLMAddress, error) {
+	if pub, err := xlmutil.DecodeAccountID(address); err == nil {
+		return &XLMAddress{addr: address, pubKey: pub}, nil
+	}
+
+	pub, id, err := xlmutil.DecodeMuxedAccount(address)
+	if err != nil {
+		return nil, ErrAddressFormat
+	}
+	addr, err := xlmutil.EncodeAccountID(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	return &XLMAddress{
+		addr:   addr,
+		pubKey: pub,
+		id:     id,
+		hasID:  true,
+	}, nil
+}
+
+func CheckXLMAddress(address string) bool {
+	_, err := ParseXLMAddress(address)
+	return err == nil
+}
+
+func (a *XLMAddress) String() string {
+	return a.addr
+}
+
+func (a *XLMAddress) Url() string {
+	return a.String()
+}
+
+// ID returns the ID of the muxed account the address was parsed from, if
+// any.
+func (a *XLMAddress) ID() (uint64, bool) {
+	return a.id, a.hasID
+}
+
+// MuxedAddress returns the muxed account of a with the ID id.
+func (a *XLMAddress) MuxedAddress(id uint64) (string, error) {
+	return xlmutil.EncodeMuxedAccount(a.pubKey, id)
+}
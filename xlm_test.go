@@ -0,0 +1,82 @@
+package addressutil
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/suyhuai/addressutil/ecc"
+	"github.com/suyhuai/addressutil/util/xlmutil"
+)
+
+// Test vectors from SEP-23.
+func TestXLMAddress(t *testing.T) {
+	pub, _ := hex.DecodeString("3f0c34bf93ad0d9971d04ccc90f705511c838aad9734a4a2fb0d7a03fc7fe89a")
+	account := "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"
+
+	a, err := NewXLMAddress(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.String() != account {
+		t.Log("Address mismatch", a, account)
+		t.Fail()
+	}
+
+	muxed := []struct {
+		id   uint64
+		addr string
+	}{
+		{0, "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAAACJUQ"},
+		{1234, "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAE2JUG6"},
+		{9223372036854775808, "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVAAAAAAAAAAAAAJLK"},
+	}
+	for _, test := range muxed {
+		if addr, err := a.MuxedAddress(test.id); err != nil || addr != test.addr {
+			t.Log("Muxed address mismatch", addr, test.addr, err)
+			t.Fail()
+		}
+
+		parsed, err := ParseAddress(test.addr, "XLM", MainNet)
+		if err != nil {
+			t.Log("Muxed address parse failed", test.addr, err)
+			t.Fail()
+			continue
+		}
+		if id, ok := parsed.(*XLMAddress).ID(); parsed.String() != account || !ok || id != test.id {
+			t.Log("Parsed muxed address mismatch", parsed, id, ok)
+			t.Fail()
+		}
+	}
+
+	seed, _ := hex.DecodeString("2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7")
+	secret, err := xlmutil.EncodeSeed(seed)
+	if err != nil || secret != "SAVUXZ7RT3RHXPZQYZT3MQWV6SVGT7IWTBZPR7BQLHAI5OXC5MM6ORCR" {
+		t.Log("Seed mismatch", secret, err)
+		t.Fail()
+	}
+	decoded, err := xlmutil.DecodeSeed(secret)
+	if err != nil || !bytes.Equal(decoded, seed) {
+		t.Log("Seed decode mismatch", decoded, err)
+		t.Fail()
+	}
+	priv, _ := ecc.NewEd25519PrivateKey(seed)
+	if a, err := NewAddress("XLM", priv, MainNet); err != nil || a.String() != "GCSLFBLL73CRBK5LRF2T7LA2YDQRCI3E47JFAVCZMPYTL4VDGGEO3RF7" {
+		t.Log("Seed address mismatch", a, err)
+		t.Fail()
+	}
+
+	for _, addr := range []string{
+		"",
+		secret,
+		"GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGY",
+		"GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSG",
+		"MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAAACJUR",
+		"ga7qynf7sowq3glr2bgmzehxavirza4kvwltjjfc7mgxua74p7ujvsgz",
+	} {
+		if CheckAddress(addr, "XLM", MainNet) {
+			t.Log("Invalid address accepted", addr)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package addressutil
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"strings"
+
+	"github.com/suyhuai/addressutil/util/xrputil"
+)
+
+// XRPAddress is a classic ("r…") XRP Ledger address.  Addresses parsed from
+// an X-address keep the destination tag it carried.
+type XRPAddress struct {
+	addr   string
+	pubKey []byte
+	tag    uint32
+	hasTag bool
+}
+
+// NewXRPAddress accepts any ed25519 key input understood by NewAddress, a 33
+// byte ed25519 key behind the 0xED prefix (raw or hex encoded) or any
+// secp256k1 key input understood by NewAddress.
+func NewXRPAddress(pubKey interface{}) (*XRPAddress, error) {
+	pub := xrpEd25519Key(pubKey)
+	if pub == nil {
+		edKey, err := parseEd25519PubKey(pubKey)
+		switch err {
+		case nil:
+			pub = append([]byte{xrputil.ED25519Prefix}, edKey...)
+		case ErrKeyType:
+			key, _, err := parsePubKey(pubKey)
+			if err != nil {
+				return nil, err
+			}
+			pub = key.SerializeCompressed()
+		default:
+			return nil, err
+		}
+	}
+
+	addr, err := xrputil.EncodeClassicAddress(xrputil.AccountID(pub))
+	if err != nil {
+		return nil, err
+	}
+
+	return &XRPAddress{
+		addr:   addr,
+		pubKey: pub,
+	}, nil
+}
+
+// ParseXRPAddress parses a classic address or an X-address of the network
+// net.  The destination tag of an X-address is available through Tag.
+func ParseXRPAddress(address string, net Network) (*XRPAddress, error) {
+	if net != MainNet && net != TestNet {
+		return nil, ErrUnsupportedNetwork
+	}
+
+	if _, err := xrputil.DecodeClassicAddress(address); err == nil {
+		return &XRPAddress{addr: address}, nil
+	}
+
+	accountID, tag, hasTag, test, err := xrputil.DecodeXAddress(address)
+	if err != nil || test != (net == TestNet) {
+		return nil, ErrAddressFormat
+	}
+	addr, err := xrputil.EncodeClassicAddress(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &XRPAddress{
+		addr:   addr,
+		tag:    tag,
+		hasTag: hasTag,
+	}, nil
+}
+
+func CheckXRPAddress(address string, net Network) bool {
+	_, err := ParseXRPAddress(address, net)
+	return err == nil
+}
+
+func (a *XRPAddress) String() string {
+	return a.addr
+}
+
+func (a *XRPAddress) Url() string {
+	return a.String()
+}
+
+// Tag returns the destination tag the address was parsed with, if any.
+func (a *XRPAddress) Tag() (uint32, bool) {
+	return a.tag, a.hasTag
+}
+
+// XAddress returns the X-address of a on the network net, embedding the
+// destination tag tag when hasTag is set.
+func (a *XRPAddress) XAddress(tag uint32, hasTag bool, net Network) (string, error) {
+	if net != MainNet && net != TestNet {
+		return "", ErrUnsupportedNetwork
+	}
+	accountID, err := xrputil.DecodeClassicAddress(a.addr)
+	if err != nil {
+		return "", err
+	}
+	return xrputil.EncodeXAddress(accountID, tag, hasTag, net == TestNet)
+}
+
+// xrpEd25519Key returns pubKey if it is an ed25519 key already carrying the
+// 0xED prefix, or nil.
+func xrpEd25519Key(pubKey interface{}) []byte {
+	var key []byte
+	switch k := pubKey.(type) {
+	case []byte:
+		key = k
+	case string:
+		key, _ = hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(k, "0x"), "0X"))
+	}
+	if len(key) == ed25519.PublicKeySize+1 && key[0] == xrputil.ED25519Prefix {
+		return key
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package addressutil
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+)
+
+func TestXRPAddress(t *testing.T) {
+	edKey, _ := hex.DecodeString("9434799226374926EDA3B54B1B461B4ABF7237962EAE18528FEA67595397FA32")
+	keys := []struct {
+		pubKey interface{}
+		addr   string
+	}{
+		{"0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020", "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+		{"ED9434799226374926EDA3B54B1B461B4ABF7237962EAE18528FEA67595397FA32", "rDTXLQ7ZKZVKz33zJbHjgVShjsBnqMBhmN"},
+		{ed25519.PublicKey(edKey), "rDTXLQ7ZKZVKz33zJbHjgVShjsBnqMBhmN"},
+		// Raw 32 byte ed25519 keys get the 0xED prefix added.
+		{edKey, "rDTXLQ7ZKZVKz33zJbHjgVShjsBnqMBhmN"},
+		{"9434799226374926EDA3B54B1B461B4ABF7237962EAE18528FEA67595397FA32", "rDTXLQ7ZKZVKz33zJbHjgVShjsBnqMBhmN"},
+	}
+
+	for _, k := range keys {
+		if a, err := NewXRPAddress(k.pubKey); err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if a.String() != k.addr {
+			t.Log("Address mismatch", a, k.addr)
+			t.Fail()
+		}
+	}
+
+	if a, err := NewAddress("XRP", edKey, MainNet); err != nil || a.String() != keys[1].addr {
+		t.Log("Address mismatch", a, err)
+		t.Fail()
+	}
+	if _, err := NewXRPAddress("9434799226"); err != ErrPublicKeyFormat {
+		t.Log("unexpected error", err)
+		t.Fail()
+	}
+}
+
+func TestCheckXRPAddress(t *testing.T) {
+	validAddresses := []string{
+		"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		"XVLhHMPHU98es4dbozjVtdWzVrDjtV5fdx1mHp98tDMoQXb",
+	}
+	invalidAddresses := []string{
+		"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTi",
+		"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		"TVE26TYGhfLC7tQDno7G8dGtxSkYQn49b3qD26PK7FcGSKE",
+	}
+
+	for _, address := range validAddresses {
+		if !CheckXRPAddress(address, MainNet) {
+			t.Log("expected valid", address)
+			t.Fail()
+		}
+	}
+
+	for _, address := range invalidAddresses {
+		if CheckXRPAddress(address, MainNet) {
+			t.Log("expected invalid", address)
+			t.Fail()
+		}
+	}
+}
+
+func TestXRPXAddress(t *testing.T) {
+	tests := []struct {
+		net      Network
+		tag      uint32
+		hasTag   bool
+		xAddress string
+	}{
+		{MainNet, 0, false, "XVLhHMPHU98es4dbozjVtdWzVrDjtV5fdx1mHp98tDMoQXb"},
+		{MainNet, 1, true, "XVLhHMPHU98es4dbozjVtdWzVrDjtV8xvjGQTYPiAx6gwDC"},
+		{MainNet, 4294967295, true, "XVLhHMPHU98es4dbozjVtdWzVrDjtV18pX8yuPT7y4xaEHi"},
+		{TestNet, 0, false, "TVE26TYGhfLC7tQDno7G8dGtxSkYQn49b3qD26PK7FcGSKE"},
+		{TestNet, 1, true, "TVE26TYGhfLC7tQDno7G8dGtxSkYQnSz1uDimDdPYXzSpyw"},
+	}
+	classic := "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"
+
+	for _, test := range tests {
+		a, err := ParseXRPAddress(test.xAddress, test.net)
+		if err != nil {
+			t.Log(err)
+			t.Fail()
+			continue
+		}
+		if a.String() != classic {
+			t.Log("Address mismatch", a, classic)
+			t.Fail()
+		}
+		if tag, ok := a.Tag(); tag != test.tag || ok != test.hasTag {
+			t.Log("tag mismatch", tag, ok, test.tag, test.hasTag)
+			t.Fail()
+		}
+		if x, err := a.XAddress(test.tag, test.hasTag, test.net); err != nil || x != test.xAddress {
+			t.Log("X-address mismatch", x, test.xAddress, err)
+			t.Fail()
+		}
+	}
+
+	if a, err := ParseAddress("XVLhHMPHU98es4dbozjVtdWzVrDjtV8xvjGQTYPiAx6gwDC", "XRP", MainNet); err != nil {
+		t.Log(err)
+		t.Fail()
+	} else if tag, ok := a.(*XRPAddress).Tag(); !ok || tag != 1 {
+		t.Log("tag not surfaced by ParseAddress", tag, ok)
+		t.Fail()
+	}
+}
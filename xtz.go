@@ -0,0 +1,97 @@
+package addressutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"strings"
+
+	"github.com/suyhuai/addressutil/util/xtzutil"
+)
+
+// XTZAddress is a Tezos implicit ("tz1…", "tz2…", "tz3…") or originated
+// ("KT1…") address.
+type XTZAddress struct {
+	addr   string
+	pubKey string
+}
+
+// NewXTZAddress returns the implicit address of pubKey.  ed25519 keys give a
+// tz1 address and secp256k1 keys a tz2 address; P-256 keys, which give a tz3
+// address, are passed as an *ecdsa.PublicKey or *ecdsa.PrivateKey.  pubKey
+// may also be an "edpk…", "sppk…" or "p2pk…" string.
+func NewXTZAddress(pubKey interface{}) (*XTZAddress, error) {
+	curve, key, err := parseXTZPubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := xtzutil.PublicKeyHash(curve, key)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := xtzutil.EncodePublicKey(curve, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &XTZAddress{
+		addr:   addr,
+		pubKey: pub,
+	}, nil
+}
+
+func (a *XTZAddress) String() string {
+	return a.addr
+}
+
+func (a *XTZAddress) Url() string {
+	return a.String()
+}
+
+// PublicKey returns the "edpk…", "sppk…" or "p2pk…" string of the key the
+// address was derived from, or "" for parsed addresses.
+func (a *XTZAddress) PublicKey() string {
+	return a.pubKey
+}
+
+// CheckXTZAddress reports whether address is a valid implicit or originated
+// address.
+func CheckXTZAddress(address string) bool {
+	_, _, err := xtzutil.DecodeAddress(address)
+	return err == nil
+}
+
+func parseXTZPubKey(pubKey interface{}) (xtzutil.Curve, []byte, error) {
+	switch k := pubKey.(type) {
+	case string:
+		if strings.HasPrefix(k, "edpk") || strings.HasPrefix(k, "sppk") || strings.HasPrefix(k, "p2pk") {
+			curve, key, err := xtzutil.DecodePublicKey(k)
+			if err != nil {
+				return 0, nil, ErrPublicKeyFormat
+			}
+			return curve, key, nil
+		}
+	case *ecdsa.PrivateKey:
+		if k == nil {
+			return 0, nil, ErrPublicKeyFormat
+		}
+		return parseXTZPubKey(&k.PublicKey)
+	case *ecdsa.PublicKey:
+		if k == nil || k.Curve != elliptic.P256() || !k.Curve.IsOnCurve(k.X, k.Y) {
+			return 0, nil, ErrPublicKeyFormat
+		}
+		return xtzutil.P256, elliptic.MarshalCompressed(k.Curve, k.X, k.Y), nil
+	}
+
+	pub, err := parseEd25519PubKey(pubKey)
+	if err == ErrKeyType {
+		key, _, err := parsePubKey(pubKey)
+		if err != nil {
+			return 0, nil, err
+		}
+		return xtzutil.Secp256k1, key.SerializeCompressed(), nil
+	} else if err != nil {
+		return 0, nil, err
+	}
+	return xtzutil.Ed25519, pub, nil
+}
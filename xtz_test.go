@@ -0,0 +1,75 @@
+package addressutil
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/hex"
+	"testing"
+
+	"github.com/suyhuai/addressutil/util/xtzutil"
+)
+
+func TestXTZAddress(t *testing.T) {
+	edKey, _ := hex.DecodeString("a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed")
+	secpKey, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	p256 := elliptic.P256().Params()
+	p256Key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: p256.Gx, Y: p256.Gy}
+
+	tests := []struct {
+		pubKey interface{}
+		encKey string
+		addr   string
+	}{
+		{edKey, "edpkutkuiAU7vZsuK7QN9srMJPH5byA5ZmV6KkncnNEmmyKsdJnHdK", "tz1LB45soZi5837k9q9FBkDi2P6m3NRNy6hA"},
+		{"edpkuBknW28nW72KG6RoHtYW7p12T6GKc7nAbwYX5m8Wd9sDVC9yav", "edpkuBknW28nW72KG6RoHtYW7p12T6GKc7nAbwYX5m8Wd9sDVC9yav", "tz1KqTpEZ7Yob7QbPE4Hy4Wo8fHG8LhKxZSx"},
+		{secpKey, "sppk7aEFdrScsCDxdaQ7Ev1JxpWZESrEK6UsWRhr79JfGKkPYGTsudN", "tz2BCeQSi5ETyKJsob61pWCoQvoGtsrJBEt2"},
+		{p256Key, "p2pk67L57Q7vcgLkMrKXctFRKs5JSLR6qjiw1riJaFyakWpTv9QSkRf", "tz3bqAfFRnSA6dfPRG8XR6MBMmo6HZTTG44V"},
+		{"p2pk67L57Q7vcgLkMrKXctFRKs5JSLR6qjiw1riJaFyakWpTv9QSkRf", "p2pk67L57Q7vcgLkMrKXctFRKs5JSLR6qjiw1riJaFyakWpTv9QSkRf", "tz3bqAfFRnSA6dfPRG8XR6MBMmo6HZTTG44V"},
+	}
+
+	for _, test := range tests {
+		a, err := NewAddress("XTZ", test.pubKey, MainNet)
+		if err != nil {
+			t.Log(err)
+			t.Fail()
+			continue
+		}
+		if a.String() != test.addr {
+			t.Log("Address mismatch", a, test.addr)
+			t.Fail()
+		}
+		if pub := a.(*XTZAddress).PublicKey(); pub != test.encKey {
+			t.Log("Public key mismatch", pub, test.encKey)
+			t.Fail()
+		}
+		if !CheckAddress(test.addr, "XTZ", MainNet) {
+			t.Log("Address check failed", test.addr)
+			t.Fail()
+		}
+	}
+
+	curve, key, err := xtzutil.DecodePublicKey(tests[2].encKey)
+	if err != nil || curve != xtzutil.Secp256k1 || !bytes.Equal(key, secpKey) {
+		t.Log("Public key decode mismatch", curve, key, err)
+		t.Fail()
+	}
+
+	if _, prefix, err := xtzutil.DecodeAddress("KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn"); err != nil || !bytes.Equal(prefix, xtzutil.PrefixKT1) {
+		t.Log("Originated address decode failed", prefix, err)
+		t.Fail()
+	}
+
+	for _, addr := range []string{
+		"",
+		"tz1KqTpEZ7Yob7QbPE4Hy4Wo8fHG8LhKxZSy",
+		"tz1KqTpEZ7Yob7QbPE4Hy4Wo8fHG8LhKxZS",
+		"edpkuBknW28nW72KG6RoHtYW7p12T6GKc7nAbwYX5m8Wd9sDVC9yav",
+		"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+	} {
+		if CheckAddress(addr, "XTZ", MainNet) {
+			t.Log("Invalid address accepted", addr)
+			t.Fail()
+		}
+	}
+}
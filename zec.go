@@ -0,0 +1,119 @@
+package addressutil
+
+import (
+	"errors"
+
+	"github.com/suyhuai/addressutil/hash160"
+	"github.com/suyhuai/addressutil/util/utxoutil"
+	"github.com/suyhuai/addressutil/util/zecutil"
+)
+
+var ErrNoTransparentReceiver = errors.New("unified address has no transparent receiver")
+
+// ZECAddress is a transparent P2PKH Zcash address.
+type ZECAddress struct {
+	params *zecutil.Params
+	addr   string
+	pubKey []byte
+}
+
+func NewZECAddress(pubKey interface{}, net Network) (*ZECAddress, error) {
+	_, serialized, err := parsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := zecNetParams(net)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := utxoutil.NewAddressPubKeyHash(hash160.Hash160(serialized), &params.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ZECAddress{
+		params: params,
+		addr:   addr.EncodeAddress(),
+		pubKey: serialized,
+	}, nil
+}
+
+func (a *ZECAddress) String() string {
+	return a.addr
+}
+
+func (a *ZECAddress) Url() string {
+	return a.String()
+}
+
+// CheckZECAddress reports whether address is a transparent P2PKH or P2SH
+// address or a unified address of the Zcash network net.
+func CheckZECAddress(address string, net Network) bool {
+	params, err := zecNetParams(net)
+	if err != nil {
+		return false
+	}
+	if _, err := decodeBase58Address(address, &params.Params); err == nil {
+		return true
+	}
+	_, err = zecutil.DecodeUnifiedAddress(address, params.UnifiedHRP)
+	return err == nil
+}
+
+// ParseZECUnifiedAddress decodes the unified address address of the Zcash
+// network net.
+func ParseZECUnifiedAddress(address string, net Network) (*zecutil.UnifiedAddress, error) {
+	params, err := zecNetParams(net)
+	if err != nil {
+		return nil, err
+	}
+	return zecutil.DecodeUnifiedAddress(address, params.UnifiedHRP)
+}
+
+// ZECTransparentAddress returns the transparent address a Zcash address can
+// be paid at without shielding: a transparent address itself, or the t-address
+// of the transparent receiver of a unified address.
+func ZECTransparentAddress(address string, net Network) (string, error) {
+	params, err := zecNetParams(net)
+	if err != nil {
+		return "", err
+	}
+	if _, err := decodeBase58Address(address, &params.Params); err == nil {
+		return address, nil
+	}
+
+	ua, err := zecutil.DecodeUnifiedAddress(address, params.UnifiedHRP)
+	if err != nil {
+		return "", ErrAddressFormat
+	}
+	typ, hash, ok := ua.Transparent()
+	if !ok {
+		return "", ErrNoTransparentReceiver
+	}
+	var taddr utxoutil.Address
+	if typ == zecutil.TypeP2SH {
+		taddr, err = utxoutil.NewAddressScriptHashFromHash(hash, &params.Params)
+	} else {
+		taddr, err = utxoutil.NewAddressPubKeyHash(hash, &params.Params)
+	}
+	if err != nil {
+		return "", ErrAddressFormat
+	}
+	return taddr.EncodeAddress(), nil
+}
+
+// zecNetParams returns the address parameters of the Zcash network net.
+func zecNetParams(net Network) (*zecutil.Params, error) {
+	switch net {
+	case MainNet:
+		return &zecutil.MainNetParams, nil
+	case TestNet:
+		return &zecutil.TestNetParams, nil
+	case RegTest:
+		return &zecutil.RegressionNetParams, nil
+	default:
+		return nil, ErrUnsupportedNetwork
+	}
+}
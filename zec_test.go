@@ -0,0 +1,140 @@
+package addressutil
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/suyhuai/addressutil/util/zecutil"
+)
+
+const (
+	zecUnifiedMain = "u1tvp8jw3ndlngxx4sadcnv8yftk2x8z3k8368hw799z9a6n428yjcuhzty5jwjnqc20rs500p2ewf7pjrd4v80q8z5fl78ydhegl05e2v8ffzpj7y8c7dgg3rpag7uuc2qjspygv0xyh"
+	zecUnifiedTest = "utest1efq2y6rr0y84gsjkjpsk7wxnnczl5twg7ymfp2l7qhpk3mt0scsrfd0ajuqeqqxgwq7yafeg7lfcveetqje4vr08eg6kjxn2s7d5mgunsdk8n48nrawnqag2es7667ad57l8se0y9fuv360a2a797na466z0qj5r8dcmfsz3hh4mj492pvn0nv5cfy749yum0t5fh74q526mjxrlfa4"
+	zecOrchardOnly = "u19w0wmqnyrcx4kx9yxj3l9ewxzpqtrxqq62xswtkhu5r0temhnlhfpde2fud9xa6esrjdqknqsx0qkm8w3qfmue586pc0x3arjvhxc35u"
+)
+
+func TestZECAddress(t *testing.T) {
+	pub, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	tests := []struct {
+		net  Network
+		addr string
+	}{
+		{MainNet, "t1UYsZVJkLPeMjxEtACvSxfWuNmddpWfxzs"},
+		{TestNet, "tmLPctKo9j49rtCSKpwEBpLBeykiTGomGQs"},
+	}
+
+	for _, test := range tests {
+		if a, err := NewZECAddress(pub, test.net); err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if a.String() != test.addr {
+			t.Log("Address mismatch", a, test.addr)
+			t.Fail()
+		}
+	}
+}
+
+// First F4Jumble vector of zcash-test-vectors (test-vectors/f4jumble), the
+// reference vectors of ZIP 316.
+func TestZECF4JumbleVector(t *testing.T) {
+	normal, _ := hex.DecodeString("5d7a8f739a2d9e945b0ce152a8049e294c4d6e66b164939daffa2ef6ee6921481cdd86b3cc4318d9614fc820905d042b")
+	jumbled, _ := hex.DecodeString("0304d029141b995da5387c125970673504d6c764d91ea6c082123770c7139ccd88ee27368cd0c0921a0444c8e5858d22")
+
+	if out, err := zecutil.F4Jumble(normal); err != nil || !bytes.Equal(out, jumbled) {
+		t.Log("F4Jumble mismatch", hex.EncodeToString(out), err)
+		t.Fail()
+	}
+	if out, err := zecutil.F4JumbleInv(jumbled); err != nil || !bytes.Equal(out, normal) {
+		t.Log("F4JumbleInv mismatch", hex.EncodeToString(out), err)
+		t.Fail()
+	}
+}
+
+func TestCheckZECAddress(t *testing.T) {
+	validAddresses := []string{
+		"t1UYsZVJkLPeMjxEtACvSxfWuNmddpWfxzs",
+		"t3VEtV2oBtHxjq7wKHJb3PHsqXHvMRgUmVw",
+		zecUnifiedMain,
+		zecOrchardOnly,
+	}
+	invalidAddresses := []string{
+		"t1UYsZVJkLPeMjxEtACvSxfWuNmddpWfxzt",
+		"tmLPctKo9j49rtCSKpwEBpLBeykiTGomGQs",
+		"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		zecUnifiedTest,
+		// A unified address without a shielded receiver.
+		"u1upkjks6tkzm4a8qepj6nkgekevnxevq8z2ngpmycnywxwen3g0keltjgtu3z5c8ehwj",
+		// Receivers out of typecode order.
+		"u17x9eptaqddwtpled8g2fmzgmw0t9ek5y96auawkdfvtpmckj78q6dxhcmy8xmsatz3htwpgwhfm7mgcaeep78xyk3eqc66ddy37q37r3dmuqphwtrcqvfgnyca4skk9l569sc5kdkvl",
+	}
+
+	for _, address := range validAddresses {
+		if !CheckZECAddress(address, MainNet) {
+			t.Log("expected valid", address)
+			t.Fail()
+		}
+	}
+
+	for _, address := range invalidAddresses {
+		if CheckZECAddress(address, MainNet) {
+			t.Log("expected invalid", address)
+			t.Fail()
+		}
+	}
+
+	for _, address := range []string{"tmLPctKo9j49rtCSKpwEBpLBeykiTGomGQs", "t2HE5XhuKkka7NpX4D3b5vv4Udn9XGqUwEt", zecUnifiedTest} {
+		if !CheckZECAddress(address, TestNet) {
+			t.Log("expected valid on testnet", address)
+			t.Fail()
+		}
+	}
+}
+
+func TestZECUnifiedAddress(t *testing.T) {
+	tests := []struct {
+		net         Network
+		address     string
+		transparent string
+		receivers   int
+	}{
+		{MainNet, zecUnifiedMain, "t1UYsZVJkLPeMjxEtACvSxfWuNmddpWfxzs", 2},
+		{TestNet, zecUnifiedTest, "t2HE5XhuKkka7NpX4D3b5vv4Udn9XGqUwEt", 3},
+		{MainNet, "t3VEtV2oBtHxjq7wKHJb3PHsqXHvMRgUmVw", "t3VEtV2oBtHxjq7wKHJb3PHsqXHvMRgUmVw", 0},
+	}
+
+	for _, test := range tests {
+		taddr, err := ZECTransparentAddress(test.address, test.net)
+		if err != nil {
+			t.Log(err)
+			t.Fail()
+		} else if taddr != test.transparent {
+			t.Log("transparent address mismatch", taddr, test.transparent)
+			t.Fail()
+		}
+		if test.receivers == 0 {
+			continue
+		}
+
+		ua, err := ParseZECUnifiedAddress(test.address, test.net)
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		if len(ua.Receivers) != test.receivers {
+			t.Log("receiver count mismatch", len(ua.Receivers), test.receivers)
+			t.Fail()
+		}
+
+		params, _ := zecNetParams(test.net)
+		if encoded, err := zecutil.EncodeUnifiedAddress(ua, params.UnifiedHRP); err != nil || encoded != test.address {
+			t.Log("unified address round trip failed", encoded, err)
+			t.Fail()
+		}
+	}
+
+	if _, err := ZECTransparentAddress(zecOrchardOnly, MainNet); err != ErrNoTransparentReceiver {
+		t.Log("unexpected error", err)
+		t.Fail()
+	}
+}